@@ -1,10 +1,11 @@
 package main
 
 import (
+	"context"
 	"dennic_user_service/internal/app"
 	"dennic_user_service/internal/pkg/config"
+	"flag"
 	"log"
-	"os"
 	"os/signal"
 	"syscall"
 
@@ -12,8 +13,14 @@ import (
 )
 
 func main() {
-	// initialization config
+	// the root context is cancelled on the first shutdown signal, a second signal kills the process
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// initialization config, the mode flag overrides APP_MODE
 	config := config.New()
+	flag.StringVar(&config.Mode, "mode", config.Mode, "components to run: grpc, consumer or all")
+	flag.Parse()
 
 	// initialization app
 	app, err := app.NewApp(config)
@@ -22,20 +29,13 @@ func main() {
 	}
 
 	// runing
-	go func() {
-		if err := app.Run(); err != nil {
-			app.Logger.Error("app run", zap.Error(err))
-		}
-	}()
-
-	// graceful shutdown
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	<-sigs
+	if err := app.Run(ctx); err != nil {
+		app.Logger.Error("app run", zap.Error(err))
+	}
+	stop()
 
-	app.Logger.Info("Conten service stops !")
+	app.Logger.Info("User service stops !")
 
-	// app stops
+	// graceful shutdown
 	app.Stop()
-
 }
@@ -48,6 +48,8 @@ type Admin struct {
 	CreatedAt            string   `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	FailedLoginAttempts  uint64   `protobuf:"varint,21,opt,name=failed_login_attempts,json=failedLoginAttempts,proto3" json:"failed_login_attempts"`
+	LockedUntil          string   `protobuf:"bytes,22,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -226,6 +228,20 @@ func (m *Admin) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Admin) GetFailedLoginAttempts() uint64 {
+	if m != nil {
+		return m.FailedLoginAttempts
+	}
+	return 0
+}
+
+func (m *Admin) GetLockedUntil() string {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return ""
+}
+
 type GetAdminReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -296,6 +312,8 @@ type ListAdminsReq struct {
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
 	Field                string   `protobuf:"bytes,5,opt,name=field,proto3" json:"field"`
 	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	IsLocked             bool     `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked"`
+	PageToken            string   `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -376,9 +394,24 @@ func (m *ListAdminsReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *ListAdminsReq) GetIsLocked() bool {
+	if m != nil {
+		return m.IsLocked
+	}
+	return false
+}
+
+func (m *ListAdminsReq) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListAdminsResp struct {
 	Admins               []*Admin `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins"`
 	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -431,6 +464,13 @@ func (m *ListAdminsResp) GetCount() uint64 {
 	return 0
 }
 
+func (m *ListAdminsResp) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type ChangeAdminPasswordReq struct {
 	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
 	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email"`
@@ -756,6 +796,7 @@ func (m *CheckAdminDeleteResp) GetStatus() bool {
 type UpdateRefreshTokenAdminReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -808,6 +849,13 @@ func (m *UpdateRefreshTokenAdminReq) GetRefreshToken() string {
 	return ""
 }
 
+func (m *UpdateRefreshTokenAdminReq) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
 type UpdateRefreshTokenAdminResp struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -855,1407 +903,3556 @@ func (m *UpdateRefreshTokenAdminResp) GetStatus() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Admin)(nil), "user.Admin")
-	proto.RegisterType((*GetAdminReq)(nil), "user.GetAdminReq")
-	proto.RegisterType((*ListAdminsReq)(nil), "user.ListAdminsReq")
-	proto.RegisterType((*ListAdminsResp)(nil), "user.ListAdminsResp")
-	proto.RegisterType((*ChangeAdminPasswordReq)(nil), "user.ChangeAdminPasswordReq")
-	proto.RegisterType((*DeleteAdminReq)(nil), "user.DeleteAdminReq")
-	proto.RegisterType((*ChangeAdminPasswordResp)(nil), "user.ChangeAdminPasswordResp")
-	proto.RegisterType((*CheckAdminFieldReq)(nil), "user.CheckAdminFieldReq")
-	proto.RegisterType((*CheckAdminFieldResp)(nil), "user.CheckAdminFieldResp")
-	proto.RegisterType((*CheckAdminDeleteResp)(nil), "user.CheckAdminDeleteResp")
-	proto.RegisterType((*UpdateRefreshTokenAdminReq)(nil), "user.UpdateRefreshTokenAdminReq")
-	proto.RegisterType((*UpdateRefreshTokenAdminResp)(nil), "user.UpdateRefreshTokenAdminResp")
+type VerifyAdminCredentialsReq struct {
+	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email"`
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("user_service/admin.proto", fileDescriptor_cc32bb425e570901) }
-
-var fileDescriptor_cc32bb425e570901 = []byte{
-	// 815 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0x66, 0xed, 0x8d, 0x6b, 0x3f, 0xc7, 0x6e, 0x3b, 0x89, 0xca, 0x74, 0xdb, 0x04, 0x77, 0x2b,
-	0x90, 0x2f, 0x04, 0x51, 0x84, 0x90, 0x38, 0xe1, 0xa6, 0xa2, 0x07, 0xa0, 0xc0, 0x42, 0x40, 0x39,
-	0xad, 0xc6, 0xde, 0x17, 0x7b, 0xe5, 0xfd, 0xc5, 0xcc, 0x38, 0x91, 0xff, 0x13, 0x2e, 0xfc, 0x3f,
-	0x1c, 0x39, 0x70, 0x07, 0x85, 0x7f, 0x04, 0xcd, 0x9b, 0x5d, 0x67, 0x1d, 0xc7, 0x46, 0x48, 0xbd,
-	0xf9, 0x7d, 0xdf, 0x37, 0x6f, 0xde, 0xcc, 0x7c, 0xdf, 0x1a, 0xf8, 0x42, 0xa1, 0x0c, 0x15, 0xca,
-	0xcb, 0x78, 0x82, 0x1f, 0x89, 0x28, 0x8d, 0xb3, 0x93, 0x42, 0xe6, 0x3a, 0x67, 0xae, 0x61, 0xfc,
-	0x3f, 0x5d, 0xd8, 0x1b, 0x19, 0x94, 0xf5, 0xa1, 0x11, 0x47, 0xdc, 0x19, 0x38, 0xc3, 0x4e, 0xd0,
-	0x88, 0x23, 0xf6, 0x1e, 0x74, 0x49, 0x1e, 0xe6, 0x32, 0x42, 0xc9, 0x1b, 0x03, 0x67, 0xd8, 0x0c,
-	0x80, 0xa0, 0x6f, 0x0d, 0xc2, 0x18, 0xb8, 0x32, 0x4f, 0x90, 0x37, 0x69, 0x09, 0xfd, 0x66, 0x47,
-	0x00, 0x17, 0xb1, 0x54, 0x3a, 0xcc, 0x44, 0x8a, 0xdc, 0x25, 0xa6, 0x43, 0xc8, 0x1b, 0x91, 0x22,
-	0x7b, 0x02, 0x9d, 0x44, 0x54, 0xec, 0x1e, 0xb1, 0x6d, 0x03, 0x10, 0x79, 0x04, 0x30, 0x8e, 0xa5,
-	0x9e, 0x85, 0x91, 0xd0, 0xc8, 0x5b, 0x76, 0x2d, 0x21, 0xaf, 0x84, 0x46, 0xf6, 0x0c, 0xf6, 0x8b,
-	0x59, 0x9e, 0x61, 0x98, 0x2d, 0xd2, 0x31, 0x4a, 0x7e, 0x8f, 0x04, 0x5d, 0xc2, 0xde, 0x10, 0xc4,
-	0x0e, 0x61, 0x0f, 0x53, 0x11, 0x27, 0xbc, 0x4d, 0x9c, 0x2d, 0x98, 0x07, 0xed, 0x42, 0x28, 0x75,
-	0x95, 0xcb, 0x88, 0x77, 0xec, 0x9e, 0x55, 0xcd, 0x1e, 0x41, 0x6b, 0x8a, 0x99, 0x39, 0x1f, 0x10,
-	0x53, 0x56, 0x06, 0x57, 0x22, 0x11, 0x72, 0xc9, 0xbb, 0x03, 0x67, 0xd8, 0x08, 0xca, 0x8a, 0x3d,
-	0x85, 0xce, 0x38, 0xce, 0xa7, 0x52, 0x14, 0xb3, 0x25, 0xdf, 0xaf, 0x46, 0x2c, 0x01, 0xf6, 0x01,
-	0xdc, 0x57, 0x5a, 0x48, 0x1d, 0x5e, 0xe5, 0x72, 0x1e, 0x2e, 0x51, 0x48, 0xde, 0x23, 0x4d, 0x8f,
-	0xe0, 0x9f, 0x73, 0x39, 0x3f, 0x47, 0x21, 0x99, 0x0f, 0x3d, 0xcc, 0xa2, 0x9a, 0xaa, 0x6f, 0xcf,
-	0x82, 0x59, 0xb4, 0xd2, 0x1c, 0x01, 0xac, 0x78, 0xc5, 0xef, 0x0f, 0x9c, 0xa1, 0x1b, 0x74, 0xae,
-	0x4a, 0x56, 0xb1, 0xe7, 0xd0, 0x93, 0x78, 0x21, 0x51, 0xcd, 0x42, 0x9d, 0xcf, 0x31, 0xe3, 0x0f,
-	0xa8, 0xc5, 0x7e, 0x09, 0xfe, 0x68, 0x30, 0x73, 0xdd, 0x71, 0x2a, 0xa6, 0x18, 0x2e, 0x64, 0xc2,
-	0x1f, 0xda, 0xa3, 0x13, 0x70, 0x26, 0x13, 0xb3, 0xc1, 0x44, 0xa2, 0xd0, 0x18, 0x85, 0x42, 0x73,
-	0x66, 0xcf, 0x52, 0x22, 0x23, 0x6d, 0xe8, 0x45, 0x11, 0x55, 0xf4, 0x81, 0xa5, 0x4b, 0xc4, 0xd2,
-	0x11, 0x26, 0x58, 0xd2, 0x87, 0x96, 0x2e, 0x91, 0x91, 0xf6, 0x7f, 0x82, 0xee, 0x6b, 0xd4, 0x64,
-	0xac, 0x00, 0x7f, 0x31, 0x0f, 0x73, 0x11, 0x63, 0x52, 0xd9, 0xcb, 0x16, 0x06, 0xbd, 0x14, 0xc9,
-	0x02, 0xc9, 0x5b, 0x9d, 0xc0, 0x16, 0x34, 0xb4, 0x0a, 0xc5, 0x44, 0xc7, 0x97, 0xd6, 0x5b, 0xed,
-	0xa0, 0x1d, 0xab, 0x11, 0xd5, 0xfe, 0x6f, 0x0e, 0xf4, 0xbe, 0x8e, 0x95, 0xed, 0xac, 0x4c, 0x6b,
-	0x06, 0x6e, 0x21, 0xa6, 0x48, 0x9d, 0xdd, 0x80, 0x7e, 0x9b, 0xc6, 0x49, 0x9c, 0xc6, 0x9a, 0x1a,
-	0xbb, 0x81, 0x2d, 0x76, 0x36, 0xbe, 0x99, 0xc5, 0xad, 0xcf, 0xb2, 0x9a, 0x7b, 0xaf, 0x3e, 0xf7,
-	0x63, 0x68, 0x53, 0x26, 0xc2, 0xf1, 0xb2, 0xb4, 0xe9, 0x3d, 0xaa, 0x5f, 0x2e, 0xfd, 0xaf, 0xa0,
-	0x5f, 0x1f, 0x4f, 0x15, 0xec, 0x39, 0xb4, 0x28, 0x33, 0x8a, 0x3b, 0x83, 0xe6, 0xb0, 0xfb, 0xa2,
-	0x7b, 0x62, 0x72, 0x77, 0x62, 0xaf, 0xa6, 0xa4, 0xcc, 0x3e, 0x93, 0x7c, 0x91, 0xad, 0x06, 0xa6,
-	0xc2, 0x4f, 0xe1, 0xd1, 0xe9, 0x4c, 0x64, 0x53, 0x24, 0xf1, 0x77, 0xa5, 0x67, 0xcd, 0xa1, 0x6f,
-	0x67, 0xc1, 0xd9, 0x91, 0x85, 0xc6, 0xb6, 0x2c, 0x34, 0xd7, 0xb3, 0xe0, 0x9f, 0x43, 0xff, 0x15,
-	0x3d, 0xe0, 0xdb, 0x7f, 0xb6, 0x8f, 0xe1, 0xdd, 0x3b, 0x4f, 0xa2, 0x0a, 0x4a, 0x9a, 0x16, 0x7a,
-	0xa1, 0x68, 0x93, 0x76, 0x50, 0x56, 0xfe, 0x17, 0xc0, 0x4e, 0x67, 0x38, 0x99, 0xd3, 0x8a, 0x2f,
-	0xcd, 0xc6, 0xff, 0x73, 0x22, 0xff, 0x43, 0x38, 0xd8, 0xe8, 0xb0, 0x63, 0xc3, 0x13, 0x38, 0xbc,
-	0x91, 0xdb, 0x8b, 0xd8, 0xa9, 0xff, 0x1e, 0xbc, 0x33, 0x8a, 0x43, 0x50, 0x8b, 0xdc, 0xea, 0xea,
-	0x6e, 0x7f, 0x4d, 0x37, 0xf2, 0xda, 0xd8, 0xcc, 0xab, 0xff, 0x29, 0x3c, 0xd9, 0xda, 0x72, 0xfb,
-	0x24, 0x2f, 0xfe, 0x6a, 0xc2, 0x3e, 0xa9, 0x7e, 0xb0, 0x9f, 0x79, 0xe6, 0x43, 0xeb, 0x94, 0x82,
-	0xcc, 0xea, 0x6e, 0xf3, 0xea, 0x85, 0xd1, 0xd8, 0xbd, 0x76, 0x68, 0xde, 0x87, 0xe6, 0x6b, 0xd4,
-	0xec, 0xa1, 0xc5, 0x6a, 0x81, 0x5e, 0x97, 0x7d, 0x06, 0x70, 0x63, 0x7a, 0x76, 0x60, 0xa9, 0xb5,
-	0x94, 0x7a, 0x87, 0x9b, 0xa0, 0x2a, 0xd8, 0xe7, 0xd0, 0xb2, 0x17, 0xcd, 0x4a, 0x7e, 0xdd, 0x7f,
-	0x9e, 0x67, 0xd1, 0x3b, 0x9f, 0x65, 0x04, 0x40, 0x38, 0x3d, 0x2c, 0xe3, 0xb7, 0x95, 0x95, 0x63,
-	0xbc, 0xc7, 0x5b, 0x18, 0x55, 0xb0, 0x6f, 0xa0, 0x6f, 0x5d, 0x59, 0x19, 0x92, 0x3d, 0xad, 0xc4,
-	0x77, 0xa5, 0xce, 0x3b, 0xda, 0xc1, 0xaa, 0x82, 0x9d, 0x03, 0xdb, 0x7c, 0x3d, 0x36, 0xb0, 0x8b,
-	0xb6, 0x5b, 0xc5, 0x7b, 0xf6, 0x1f, 0x0a, 0x55, 0xbc, 0x7c, 0xf0, 0xfb, 0xf5, 0xb1, 0xf3, 0xc7,
-	0xf5, 0xb1, 0xf3, 0xf7, 0xf5, 0xb1, 0xf3, 0xeb, 0x3f, 0xc7, 0xef, 0x8c, 0x5b, 0xf4, 0x27, 0xfe,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0x49, 0xea, 0x51, 0xe0, 0x07, 0x00, 0x00,
+func (m *VerifyAdminCredentialsReq) Reset()         { *m = VerifyAdminCredentialsReq{} }
+func (m *VerifyAdminCredentialsReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyAdminCredentialsReq) ProtoMessage()    {}
+func (*VerifyAdminCredentialsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AdminServiceClient is the client API for AdminService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminServiceClient interface {
-	Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
-	Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
-	Get(ctx context.Context, in *GetAdminReq, opts ...grpc.CallOption) (*Admin, error)
-	ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error)
-	Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*CheckAdminDeleteResp, error)
-	CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error)
-	ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
-	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error)
+func (m *VerifyAdminCredentialsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type adminServiceClient struct {
-	cc *grpc.ClientConn
+func (m *VerifyAdminCredentialsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAdminCredentialsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
+func (m *VerifyAdminCredentialsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAdminCredentialsReq.Merge(m, src)
+}
+func (m *VerifyAdminCredentialsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAdminCredentialsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAdminCredentialsReq.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyAdminCredentialsReq proto.InternalMessageInfo
+
+func (m *VerifyAdminCredentialsReq) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyAdminCredentialsReq) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) Get(ctx context.Context, in *GetAdminReq, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyAdminCredentialsReq) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error) {
-	out := new(ListAdminsResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/ListAdmins", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VerifyAdminCredentialsResp struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status"`
+	LockedUntil          string   `protobuf:"bytes,5,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *adminServiceClient) Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*CheckAdminDeleteResp, error) {
-	out := new(CheckAdminDeleteResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyAdminCredentialsResp) Reset()         { *m = VerifyAdminCredentialsResp{} }
+func (m *VerifyAdminCredentialsResp) String() string { return proto.CompactTextString(m) }
+func (*VerifyAdminCredentialsResp) ProtoMessage()    {}
+func (*VerifyAdminCredentialsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{13}
+}
+func (m *VerifyAdminCredentialsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyAdminCredentialsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyAdminCredentialsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *VerifyAdminCredentialsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyAdminCredentialsResp.Merge(m, src)
+}
+func (m *VerifyAdminCredentialsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyAdminCredentialsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyAdminCredentialsResp.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error) {
-	out := new(CheckAdminFieldResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/CheckField", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyAdminCredentialsResp proto.InternalMessageInfo
+
+func (m *VerifyAdminCredentialsResp) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return out, nil
+	return false
 }
 
-func (c *adminServiceClient) ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error) {
-	out := new(ChangeAdminPasswordResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyAdminCredentialsResp) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error) {
-	out := new(UpdateRefreshTokenAdminResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/UpdateRefreshToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyAdminCredentialsResp) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-// AdminServiceServer is the server API for AdminService service.
-type AdminServiceServer interface {
-	Create(context.Context, *Admin) (*Admin, error)
-	Update(context.Context, *Admin) (*Admin, error)
-	Get(context.Context, *GetAdminReq) (*Admin, error)
-	ListAdmins(context.Context, *ListAdminsReq) (*ListAdminsResp, error)
-	Delete(context.Context, *DeleteAdminReq) (*CheckAdminDeleteResp, error)
-	CheckField(context.Context, *CheckAdminFieldReq) (*CheckAdminFieldResp, error)
-	ChangePassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
-	UpdateRefreshToken(context.Context, *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error)
+func (m *VerifyAdminCredentialsResp) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServiceServer struct {
+func (m *VerifyAdminCredentialsResp) GetLockedUntil() string {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return ""
 }
 
-func (*UnimplementedAdminServiceServer) Create(ctx context.Context, req *Admin) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (*UnimplementedAdminServiceServer) Update(ctx context.Context, req *Admin) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+type UnlockAdminReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAdminServiceServer) Get(ctx context.Context, req *GetAdminReq) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (m *UnlockAdminReq) Reset()         { *m = UnlockAdminReq{} }
+func (m *UnlockAdminReq) String() string { return proto.CompactTextString(m) }
+func (*UnlockAdminReq) ProtoMessage()    {}
+func (*UnlockAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{14}
 }
-func (*UnimplementedAdminServiceServer) ListAdmins(ctx context.Context, req *ListAdminsReq) (*ListAdminsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+func (m *UnlockAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAdminServiceServer) Delete(ctx context.Context, req *DeleteAdminReq) (*CheckAdminDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *UnlockAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAdminServiceServer) CheckField(ctx context.Context, req *CheckAdminFieldReq) (*CheckAdminFieldResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+func (m *UnlockAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAdminReq.Merge(m, src)
 }
-func (*UnimplementedAdminServiceServer) ChangePassword(ctx context.Context, req *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+func (m *UnlockAdminReq) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAdminServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+func (m *UnlockAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAdminReq.DiscardUnknown(m)
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
-}
+var xxx_messageInfo_UnlockAdminReq proto.InternalMessageInfo
 
-func _AdminService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Admin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Create(ctx, req.(*Admin))
+func (m *UnlockAdminReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Admin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Update(ctx, req.(*Admin))
-	}
-	return interceptor(ctx, in, info, handler)
+type UnlockAdminResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AdminService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAdminReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Get(ctx, req.(*GetAdminReq))
+func (m *UnlockAdminResp) Reset()         { *m = UnlockAdminResp{} }
+func (m *UnlockAdminResp) String() string { return proto.CompactTextString(m) }
+func (*UnlockAdminResp) ProtoMessage()    {}
+func (*UnlockAdminResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{15}
+}
+func (m *UnlockAdminResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockAdminResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockAdminResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UnlockAdminResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAdminResp.Merge(m, src)
+}
+func (m *UnlockAdminResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockAdminResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAdminResp.DiscardUnknown(m)
 }
 
-func _AdminService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAdminsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListAdmins(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/ListAdmins",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListAdmins(ctx, req.(*ListAdminsReq))
+var xxx_messageInfo_UnlockAdminResp proto.InternalMessageInfo
+
+func (m *UnlockAdminResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AdminService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAdminReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Delete(ctx, req.(*DeleteAdminReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type RotateRefreshTokenAdminReq struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	NewRefreshToken      string   `protobuf:"bytes,2,opt,name=new_refresh_token,json=newRefreshToken,proto3" json:"new_refresh_token"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AdminService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckAdminFieldReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).CheckField(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/CheckField",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CheckField(ctx, req.(*CheckAdminFieldReq))
+func (m *RotateRefreshTokenAdminReq) Reset()         { *m = RotateRefreshTokenAdminReq{} }
+func (m *RotateRefreshTokenAdminReq) String() string { return proto.CompactTextString(m) }
+func (*RotateRefreshTokenAdminReq) ProtoMessage()    {}
+func (*RotateRefreshTokenAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{16}
+}
+func (m *RotateRefreshTokenAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateRefreshTokenAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateRefreshTokenAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RotateRefreshTokenAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRefreshTokenAdminReq.Merge(m, src)
+}
+func (m *RotateRefreshTokenAdminReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateRefreshTokenAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRefreshTokenAdminReq.DiscardUnknown(m)
 }
 
-func _AdminService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeAdminPasswordReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ChangePassword(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/ChangePassword",
+var xxx_messageInfo_RotateRefreshTokenAdminReq proto.InternalMessageInfo
+
+func (m *RotateRefreshTokenAdminReq) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ChangePassword(ctx, req.(*ChangeAdminPasswordReq))
+	return ""
+}
+
+func (m *RotateRefreshTokenAdminReq) GetNewRefreshToken() string {
+	if m != nil {
+		return m.NewRefreshToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRefreshTokenAdminReq)
-	if err := dec(in); err != nil {
-		return nil, err
+type RotateRefreshTokenAdminResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	AdminId              string   `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	ReuseDetected        bool     `protobuf:"varint,3,opt,name=reuse_detected,json=reuseDetected,proto3" json:"reuse_detected"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateRefreshTokenAdminResp) Reset()         { *m = RotateRefreshTokenAdminResp{} }
+func (m *RotateRefreshTokenAdminResp) String() string { return proto.CompactTextString(m) }
+func (*RotateRefreshTokenAdminResp) ProtoMessage()    {}
+func (*RotateRefreshTokenAdminResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{17}
+}
+func (m *RotateRefreshTokenAdminResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateRefreshTokenAdminResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateRefreshTokenAdminResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, in)
+}
+func (m *RotateRefreshTokenAdminResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRefreshTokenAdminResp.Merge(m, src)
+}
+func (m *RotateRefreshTokenAdminResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateRefreshTokenAdminResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRefreshTokenAdminResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateRefreshTokenAdminResp proto.InternalMessageInfo
+
+func (m *RotateRefreshTokenAdminResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/UpdateRefreshToken",
+	return false
+}
+
+func (m *RotateRefreshTokenAdminResp) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenAdminReq))
+	return ""
+}
+
+func (m *RotateRefreshTokenAdminResp) GetReuseDetected() bool {
+	if m != nil {
+		return m.ReuseDetected
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _AdminService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "user.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _AdminService_Create_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _AdminService_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _AdminService_Get_Handler,
-		},
-		{
-			MethodName: "ListAdmins",
-			Handler:    _AdminService_ListAdmins_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _AdminService_Delete_Handler,
-		},
-		{
-			MethodName: "CheckField",
-			Handler:    _AdminService_CheckField_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _AdminService_ChangePassword_Handler,
-		},
-		{
-			MethodName: "UpdateRefreshToken",
-			Handler:    _AdminService_UpdateRefreshToken_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "user_service/admin.proto",
+type ChangeAdminRoleReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Admin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChangeAdminRoleReq) Reset()         { *m = ChangeAdminRoleReq{} }
+func (m *ChangeAdminRoleReq) String() string { return proto.CompactTextString(m) }
+func (*ChangeAdminRoleReq) ProtoMessage()    {}
+func (*ChangeAdminRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{18}
+}
+func (m *ChangeAdminRoleReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeAdminRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeAdminRoleReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ChangeAdminRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeAdminRoleReq.Merge(m, src)
+}
+func (m *ChangeAdminRoleReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeAdminRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeAdminRoleReq.DiscardUnknown(m)
 }
 
-func (m *Admin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ChangeAdminRoleReq proto.InternalMessageInfo
+
+func (m *ChangeAdminRoleReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *Admin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ImageUrl)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.WorkYears != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.WorkYears))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.EndWorkYear) > 0 {
-		i -= len(m.EndWorkYear)
-		copy(dAtA[i:], m.EndWorkYear)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EndWorkYear)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.StartWorkYear) > 0 {
-		i -= len(m.StartWorkYear)
-		copy(dAtA[i:], m.StartWorkYear)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.StartWorkYear)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Biography) > 0 {
-		i -= len(m.Biography)
-		copy(dAtA[i:], m.Biography)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Biography)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Salary != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
-		i--
-		dAtA[i] = 0x5d
-	}
-	if len(m.Gender) > 0 {
-		i -= len(m.Gender)
-		copy(dAtA[i:], m.Gender)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Gender)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.BirthDate) > 0 {
-		i -= len(m.BirthDate)
-		copy(dAtA[i:], m.BirthDate)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BirthDate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AdminOrder != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.AdminOrder))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *ChangeAdminRoleReq) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetAdminReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ChangeAdminRoleResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAdminReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChangeAdminRoleResp) Reset()         { *m = ChangeAdminRoleResp{} }
+func (m *ChangeAdminRoleResp) String() string { return proto.CompactTextString(m) }
+func (*ChangeAdminRoleResp) ProtoMessage()    {}
+func (*ChangeAdminRoleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{19}
 }
-
-func (m *GetAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ChangeAdminRoleResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeAdminRoleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeAdminRoleResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ChangeAdminRoleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeAdminRoleResp.Merge(m, src)
+}
+func (m *ChangeAdminRoleResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeAdminRoleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeAdminRoleResp.DiscardUnknown(m)
 }
 
-func (m *ListAdminsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChangeAdminRoleResp proto.InternalMessageInfo
+
+func (m *ChangeAdminRoleResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ListAdminsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestoreAdminReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RestoreAdminReq) Reset()         { *m = RestoreAdminReq{} }
+func (m *RestoreAdminReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreAdminReq) ProtoMessage()    {}
+func (*RestoreAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{20}
+}
+func (m *RestoreAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *RestoreAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreAdminReq.Merge(m, src)
+}
+func (m *RestoreAdminReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreAdminReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreAdminReq proto.InternalMessageInfo
+
+func (m *RestoreAdminReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+type RestoreAdminResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreAdminResp) Reset()         { *m = RestoreAdminResp{} }
+func (m *RestoreAdminResp) String() string { return proto.CompactTextString(m) }
+func (*RestoreAdminResp) ProtoMessage()    {}
+func (*RestoreAdminResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{21}
+}
+func (m *RestoreAdminResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreAdminResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreAdminResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Limit != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.Page != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RestoreAdminResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreAdminResp.Merge(m, src)
+}
+func (m *RestoreAdminResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreAdminResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreAdminResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreAdminResp proto.InternalMessageInfo
+
+func (m *RestoreAdminResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ListAdminsResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*Admin)(nil), "user.Admin")
+	proto.RegisterType((*GetAdminReq)(nil), "user.GetAdminReq")
+	proto.RegisterType((*ListAdminsReq)(nil), "user.ListAdminsReq")
+	proto.RegisterType((*ListAdminsResp)(nil), "user.ListAdminsResp")
+	proto.RegisterType((*ChangeAdminPasswordReq)(nil), "user.ChangeAdminPasswordReq")
+	proto.RegisterType((*DeleteAdminReq)(nil), "user.DeleteAdminReq")
+	proto.RegisterType((*ChangeAdminPasswordResp)(nil), "user.ChangeAdminPasswordResp")
+	proto.RegisterType((*CheckAdminFieldReq)(nil), "user.CheckAdminFieldReq")
+	proto.RegisterType((*CheckAdminFieldResp)(nil), "user.CheckAdminFieldResp")
+	proto.RegisterType((*CheckAdminDeleteResp)(nil), "user.CheckAdminDeleteResp")
+	proto.RegisterType((*UpdateRefreshTokenAdminReq)(nil), "user.UpdateRefreshTokenAdminReq")
+	proto.RegisterType((*UpdateRefreshTokenAdminResp)(nil), "user.UpdateRefreshTokenAdminResp")
+	proto.RegisterType((*VerifyAdminCredentialsReq)(nil), "user.VerifyAdminCredentialsReq")
+	proto.RegisterType((*VerifyAdminCredentialsResp)(nil), "user.VerifyAdminCredentialsResp")
+	proto.RegisterType((*UnlockAdminReq)(nil), "user.UnlockAdminReq")
+	proto.RegisterType((*UnlockAdminResp)(nil), "user.UnlockAdminResp")
+	proto.RegisterType((*RotateRefreshTokenAdminReq)(nil), "user.RotateRefreshTokenAdminReq")
+	proto.RegisterType((*RotateRefreshTokenAdminResp)(nil), "user.RotateRefreshTokenAdminResp")
+	proto.RegisterType((*ChangeAdminRoleReq)(nil), "user.ChangeAdminRoleReq")
+	proto.RegisterType((*ChangeAdminRoleResp)(nil), "user.ChangeAdminRoleResp")
+	proto.RegisterType((*RestoreAdminReq)(nil), "user.RestoreAdminReq")
+	proto.RegisterType((*RestoreAdminResp)(nil), "user.RestoreAdminResp")
+}
+
+func init() { proto.RegisterFile("user_service/admin.proto", fileDescriptor_cc32bb425e570901) }
+
+var fileDescriptor_cc32bb425e570901 = []byte{
+	// 1173 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xfe, 0x29, 0x4b, 0xb2, 0x74, 0x64, 0x49, 0xf1, 0xd8, 0xf1, 0x4f, 0x33, 0xb1, 0x23, 0x33,
+	0x48, 0xe1, 0x06, 0xa8, 0x8b, 0xa6, 0x08, 0x1a, 0x74, 0x55, 0xc5, 0x41, 0x82, 0x00, 0x6e, 0x1a,
+	0xb0, 0xb5, 0x0b, 0xaf, 0x88, 0xb1, 0x78, 0x2c, 0x11, 0xa6, 0x48, 0x76, 0x66, 0x64, 0x55, 0x8f,
+	0xd1, 0x5d, 0xdf, 0xa1, 0x2f, 0x52, 0xa0, 0x9b, 0x6e, 0xbb, 0x2b, 0xdc, 0x17, 0x29, 0xe6, 0x42,
+	0x89, 0xba, 0x50, 0x41, 0x81, 0x76, 0xe7, 0xf3, 0x7d, 0x87, 0x73, 0xe6, 0xdc, 0xbe, 0x91, 0xc1,
+	0x1e, 0x71, 0x64, 0x3e, 0x47, 0x76, 0x1b, 0xf6, 0xf0, 0x53, 0x1a, 0x0c, 0xc3, 0xf8, 0x24, 0x65,
+	0x89, 0x48, 0x48, 0x59, 0x32, 0xee, 0x2f, 0x15, 0xa8, 0x74, 0x25, 0x4a, 0x5a, 0x50, 0x0a, 0x03,
+	0xdb, 0xea, 0x58, 0xc7, 0x75, 0xaf, 0x14, 0x06, 0xe4, 0x11, 0x34, 0x94, 0xbb, 0x9f, 0xb0, 0x00,
+	0x99, 0x5d, 0xea, 0x58, 0xc7, 0x1b, 0x1e, 0x28, 0xe8, 0x1b, 0x89, 0x10, 0x02, 0x65, 0x96, 0x44,
+	0x68, 0x6f, 0xa8, 0x4f, 0xd4, 0xdf, 0xe4, 0x00, 0xe0, 0x3a, 0x64, 0x5c, 0xf8, 0x31, 0x1d, 0xa2,
+	0x5d, 0x56, 0x4c, 0x5d, 0x21, 0xef, 0xe8, 0x10, 0xc9, 0x03, 0xa8, 0x47, 0x34, 0x63, 0x2b, 0x8a,
+	0xad, 0x45, 0xd4, 0x90, 0x07, 0x00, 0x57, 0x21, 0x13, 0x03, 0x3f, 0xa0, 0x02, 0xed, 0xaa, 0xfe,
+	0x56, 0x21, 0xaf, 0xa8, 0x40, 0x72, 0x04, 0x5b, 0xe9, 0x20, 0x89, 0xd1, 0x8f, 0x47, 0xc3, 0x2b,
+	0x64, 0xf6, 0xa6, 0x72, 0x68, 0x28, 0xec, 0x9d, 0x82, 0xc8, 0x2e, 0x54, 0x70, 0x48, 0xc3, 0xc8,
+	0xae, 0x29, 0x4e, 0x1b, 0xc4, 0x81, 0x5a, 0x4a, 0x39, 0x1f, 0x27, 0x2c, 0xb0, 0xeb, 0x3a, 0x66,
+	0x66, 0x93, 0x3d, 0xa8, 0xf6, 0x31, 0x96, 0xf9, 0x81, 0x62, 0x8c, 0x25, 0x71, 0x4e, 0x23, 0xca,
+	0x26, 0x76, 0xa3, 0x63, 0x1d, 0x97, 0x3c, 0x63, 0x91, 0x87, 0x50, 0xbf, 0x0a, 0x93, 0x3e, 0xa3,
+	0xe9, 0x60, 0x62, 0x6f, 0x65, 0x57, 0x34, 0x00, 0xf9, 0x08, 0xda, 0x5c, 0x50, 0x26, 0xfc, 0x71,
+	0xc2, 0x6e, 0xfc, 0x09, 0x52, 0x66, 0x37, 0x95, 0x4f, 0x53, 0xc1, 0xdf, 0x27, 0xec, 0xe6, 0x12,
+	0x29, 0x23, 0x2e, 0x34, 0x31, 0x0e, 0x72, 0x5e, 0x2d, 0x9d, 0x0b, 0xc6, 0xc1, 0xd4, 0xe7, 0x00,
+	0x60, 0xca, 0x73, 0xbb, 0xdd, 0xb1, 0x8e, 0xcb, 0x5e, 0x7d, 0x6c, 0x58, 0x4e, 0x1e, 0x43, 0x93,
+	0xe1, 0x35, 0x43, 0x3e, 0xf0, 0x45, 0x72, 0x83, 0xb1, 0x7d, 0x4f, 0x1d, 0xb1, 0x65, 0xc0, 0xef,
+	0x24, 0x26, 0xcb, 0x1d, 0x0e, 0x69, 0x1f, 0xfd, 0x11, 0x8b, 0xec, 0x6d, 0x9d, 0xba, 0x02, 0xce,
+	0x59, 0x24, 0x03, 0xf4, 0x18, 0x52, 0x81, 0x81, 0x4f, 0x85, 0x4d, 0x74, 0x2e, 0x06, 0xe9, 0x0a,
+	0x49, 0x8f, 0xd2, 0x20, 0xa3, 0x77, 0x34, 0x6d, 0x10, 0x4d, 0x07, 0x18, 0xa1, 0xa1, 0x77, 0x35,
+	0x6d, 0x90, 0xae, 0x20, 0xcf, 0xe0, 0xfe, 0x35, 0x0d, 0x23, 0x0c, 0xfc, 0x28, 0xe9, 0x87, 0xb1,
+	0x4f, 0x85, 0xc0, 0x61, 0x2a, 0xb8, 0x7d, 0x5f, 0x25, 0xb2, 0xa3, 0xc9, 0x33, 0xc9, 0x75, 0x0d,
+	0x25, 0x1b, 0x1c, 0x25, 0xbd, 0x1b, 0x0c, 0xfc, 0x51, 0x2c, 0xc2, 0xc8, 0xde, 0xd3, 0x45, 0xd1,
+	0xd8, 0xb9, 0x84, 0xdc, 0x0b, 0x68, 0xbc, 0x41, 0xa1, 0xe6, 0xd5, 0xc3, 0x1f, 0x64, 0xbf, 0xaf,
+	0x43, 0x8c, 0xb2, 0xa9, 0xd5, 0x86, 0x44, 0x6f, 0x69, 0x34, 0x42, 0x35, 0xb2, 0x75, 0x4f, 0x1b,
+	0xaa, 0x16, 0xdc, 0xa7, 0x3d, 0x11, 0xde, 0xea, 0x91, 0xad, 0x79, 0xb5, 0x90, 0x77, 0x95, 0xed,
+	0xfe, 0x61, 0x41, 0xf3, 0x2c, 0xe4, 0xfa, 0x64, 0x2e, 0x8f, 0x26, 0x50, 0x4e, 0x69, 0x1f, 0xd5,
+	0xc9, 0x65, 0x4f, 0xfd, 0x2d, 0x0f, 0x8e, 0xc2, 0x61, 0x28, 0xd4, 0xc1, 0x65, 0x4f, 0x1b, 0x6b,
+	0x0f, 0x9e, 0xdd, 0xa5, 0x9c, 0xbf, 0xcb, 0xf4, 0xde, 0x95, 0xfc, 0xbd, 0xf7, 0xa1, 0xa6, 0x56,
+	0xcd, 0xbf, 0x9a, 0x98, 0xe9, 0xdf, 0x54, 0xf6, 0xcb, 0x89, 0x89, 0xa1, 0x2b, 0x61, 0x6f, 0x66,
+	0x31, 0xce, 0x94, 0x2d, 0x5b, 0x21, 0xaf, 0x67, 0xe6, 0x40, 0x8f, 0x7e, 0x5d, 0x22, 0x6a, 0x08,
+	0x5c, 0x0e, 0xad, 0x7c, 0x6a, 0x3c, 0x25, 0x8f, 0xa1, 0xaa, 0xd6, 0x98, 0xdb, 0x56, 0x67, 0xe3,
+	0xb8, 0xf1, 0xac, 0x71, 0x22, 0xa5, 0xe0, 0x44, 0x97, 0xd5, 0x50, 0xf2, 0x8e, 0xbd, 0x64, 0x14,
+	0x4f, 0x93, 0x55, 0x86, 0x9c, 0xf0, 0x18, 0x7f, 0x14, 0x7e, 0x2e, 0xa0, 0x5e, 0xff, 0xa6, 0x84,
+	0xdf, 0x4f, 0x83, 0x0e, 0x61, 0xef, 0x74, 0x40, 0xe3, 0x3e, 0xaa, 0x43, 0xdf, 0x9b, 0x75, 0x93,
+	0x85, 0x5d, 0x5c, 0x63, 0x6b, 0xcd, 0x1a, 0x97, 0x8a, 0xd6, 0x78, 0x63, 0x7e, 0x8d, 0xdd, 0x4b,
+	0x68, 0xbd, 0x52, 0xb3, 0xf7, 0xef, 0x8f, 0xc6, 0x67, 0xf0, 0xff, 0x95, 0x99, 0xf0, 0x54, 0x89,
+	0x84, 0xa0, 0x62, 0xc4, 0x55, 0x90, 0x9a, 0x67, 0x2c, 0xf7, 0x2b, 0x20, 0xa7, 0x03, 0xec, 0xdd,
+	0xa8, 0x2f, 0x5e, 0xcb, 0xc0, 0xff, 0xf0, 0x46, 0xee, 0x27, 0xb0, 0xb3, 0x74, 0xc2, 0x9a, 0x80,
+	0x27, 0xb0, 0x3b, 0x73, 0xd7, 0x85, 0x58, 0xeb, 0x1f, 0x83, 0x73, 0xae, 0x36, 0xd9, 0xcb, 0xa9,
+	0xc5, 0xb4, 0x74, 0x8b, 0x0f, 0xc1, 0x92, 0xd4, 0x94, 0x56, 0x4b, 0x4d, 0x80, 0xf2, 0x8d, 0xf1,
+	0xc3, 0x69, 0x7b, 0x34, 0xf0, 0x36, 0x70, 0x9f, 0xc3, 0x83, 0xc2, 0x78, 0x6b, 0xae, 0x99, 0xc2,
+	0xfe, 0x05, 0xb2, 0xf0, 0x7a, 0xa2, 0x5c, 0x4f, 0x19, 0x06, 0x18, 0x8b, 0x90, 0x46, 0xfc, 0x3f,
+	0x9b, 0xa3, 0x9f, 0x2c, 0x70, 0x8a, 0x42, 0xf2, 0xd4, 0x34, 0xcb, 0x14, 0xa7, 0xe6, 0x69, 0xc3,
+	0xd4, 0xab, 0x34, 0xad, 0xd7, 0xaa, 0x77, 0x71, 0x96, 0xa2, 0x16, 0x02, 0x63, 0x2d, 0x69, 0x5e,
+	0x65, 0x59, 0xf3, 0x3a, 0xd0, 0x3a, 0x8f, 0x25, 0x50, 0xd4, 0x20, 0xf7, 0x63, 0x68, 0xcf, 0x79,
+	0xac, 0x29, 0xe9, 0x10, 0x1c, 0x2f, 0x11, 0x45, 0x9d, 0x5f, 0xea, 0xb4, 0xb5, 0xa2, 0xd3, 0x4f,
+	0x61, 0x3b, 0xc6, 0xb1, 0xbf, 0x6a, 0x24, 0xda, 0x31, 0x8e, 0xf3, 0x07, 0xbb, 0x63, 0x78, 0x50,
+	0x18, 0xae, 0xf8, 0x96, 0x52, 0x09, 0xf5, 0x4f, 0x8f, 0x69, 0x5d, 0x37, 0x95, 0xfd, 0x36, 0x20,
+	0x4f, 0xa0, 0xc5, 0x70, 0xc4, 0xd1, 0x0f, 0x50, 0x60, 0x4f, 0x60, 0x60, 0x16, 0xb6, 0xa9, 0xd0,
+	0x57, 0x06, 0x74, 0x5f, 0x00, 0xc9, 0x6d, 0xad, 0x97, 0x44, 0xb8, 0x6a, 0xb2, 0xb3, 0x4e, 0x95,
+	0x66, 0x9d, 0xd2, 0xab, 0xb7, 0xf0, 0xe5, 0x9a, 0x82, 0x1e, 0x41, 0xdb, 0x43, 0x2e, 0x12, 0x86,
+	0x85, 0xed, 0x79, 0x0a, 0xf7, 0xe6, 0x5d, 0x8a, 0x8f, 0x7b, 0xf6, 0x5b, 0x15, 0xb6, 0x94, 0xd7,
+	0xb7, 0xfa, 0x17, 0x1b, 0x71, 0xa1, 0x7a, 0xaa, 0xde, 0x64, 0x92, 0x57, 0x69, 0x27, 0x6f, 0x48,
+	0x1f, 0xbd, 0x5e, 0x6b, 0x7c, 0x9e, 0xc0, 0xc6, 0x1b, 0x14, 0x64, 0x5b, 0x63, 0xb9, 0x47, 0x74,
+	0xde, 0xed, 0x0b, 0x80, 0xd9, 0x63, 0x41, 0x76, 0x34, 0x35, 0xf7, 0x32, 0x3a, 0xbb, 0xcb, 0x20,
+	0x4f, 0xc9, 0x97, 0x50, 0xd5, 0xc2, 0x43, 0x0c, 0x3f, 0xaf, 0xc7, 0x8e, 0xa3, 0xd1, 0x95, 0x32,
+	0xd5, 0x05, 0x50, 0xb8, 0x12, 0x3a, 0x62, 0x2f, 0x7a, 0x66, 0x0a, 0xea, 0xec, 0x17, 0x30, 0x3c,
+	0x25, 0x5f, 0x43, 0x4b, 0x77, 0x2d, 0x13, 0x68, 0xf2, 0x30, 0x73, 0x5e, 0xf5, 0x0a, 0x39, 0x07,
+	0x6b, 0x58, 0x9e, 0x92, 0x4b, 0x20, 0xcb, 0x82, 0x45, 0x3a, 0xfa, 0xa3, 0x62, 0xe9, 0x74, 0x8e,
+	0x3e, 0xe0, 0xc1, 0x53, 0x72, 0x01, 0xdb, 0x5a, 0x61, 0x72, 0xe2, 0x42, 0x1e, 0xe9, 0xef, 0x0a,
+	0xd5, 0xce, 0xe9, 0xac, 0x77, 0xe0, 0x29, 0x79, 0x0e, 0x55, 0x2d, 0x02, 0x59, 0x03, 0xe6, 0x45,
+	0xc3, 0xb9, 0xbf, 0x02, 0xd5, 0x99, 0x2e, 0x6f, 0x68, 0x96, 0x69, 0xb1, 0x54, 0x38, 0x47, 0x1f,
+	0xf0, 0xe0, 0x29, 0x79, 0x0d, 0xed, 0x85, 0x4d, 0x9a, 0xf5, 0x76, 0x71, 0x35, 0x9d, 0xfd, 0x02,
+	0x86, 0xa7, 0xe4, 0x05, 0x6c, 0x9a, 0xfd, 0x21, 0x26, 0x89, 0x85, 0x8d, 0x73, 0xf6, 0x56, 0xc1,
+	0x3c, 0x7d, 0x79, 0xef, 0xd7, 0xbb, 0x43, 0xeb, 0xf7, 0xbb, 0x43, 0xeb, 0xcf, 0xbb, 0x43, 0xeb,
+	0xe7, 0xbf, 0x0e, 0xff, 0x77, 0x55, 0x55, 0xff, 0xfb, 0x7c, 0xfe, 0xf7, 0x00, 0xb6, 0x4e, 0x60,
+	0x24, 0x17, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
+	Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
+	Get(ctx context.Context, in *GetAdminReq, opts ...grpc.CallOption) (*Admin, error)
+	ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error)
+	Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*CheckAdminDeleteResp, error)
+	CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error)
+	ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
+	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error)
+	VerifyCredentials(ctx context.Context, in *VerifyAdminCredentialsReq, opts ...grpc.CallOption) (*VerifyAdminCredentialsResp, error)
+	Unlock(ctx context.Context, in *UnlockAdminReq, opts ...grpc.CallOption) (*UnlockAdminResp, error)
+	RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenAdminReq, opts ...grpc.CallOption) (*RotateRefreshTokenAdminResp, error)
+	ChangeAdminRole(ctx context.Context, in *ChangeAdminRoleReq, opts ...grpc.CallOption) (*ChangeAdminRoleResp, error)
+	Restore(ctx context.Context, in *RestoreAdminReq, opts ...grpc.CallOption) (*RestoreAdminResp, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListAdminsResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListAdminsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admins) > 0 {
-		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *adminServiceClient) Get(ctx context.Context, in *GetAdminReq, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ChangeAdminPasswordReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error) {
+	out := new(ListAdminsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListAdmins", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ChangeAdminPasswordReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*CheckAdminDeleteResp, error) {
+	out := new(CheckAdminDeleteResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ChangeAdminPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
+func (c *adminServiceClient) CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error) {
+	out := new(CheckAdminFieldResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/CheckField", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *adminServiceClient) ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error) {
+	out := new(ChangeAdminPasswordResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeleteAdminReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error) {
+	out := new(UpdateRefreshTokenAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/UpdateRefreshToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DeleteAdminReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) VerifyCredentials(ctx context.Context, in *VerifyAdminCredentialsReq, opts ...grpc.CallOption) (*VerifyAdminCredentialsResp, error) {
+	out := new(VerifyAdminCredentialsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/VerifyCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DeleteAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+func (c *adminServiceClient) Unlock(ctx context.Context, in *UnlockAdminReq, opts ...grpc.CallOption) (*UnlockAdminResp, error) {
+	out := new(UnlockAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ChangeAdminPasswordResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenAdminReq, opts ...grpc.CallOption) (*RotateRefreshTokenAdminResp, error) {
+	out := new(RotateRefreshTokenAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/RotateRefreshToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ChangeAdminPasswordResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ChangeAdminPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *adminServiceClient) ChangeAdminRole(ctx context.Context, in *ChangeAdminRoleReq, opts ...grpc.CallOption) (*ChangeAdminRoleResp, error) {
+	out := new(ChangeAdminRoleResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ChangeAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CheckAdminFieldReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) Restore(ctx context.Context, in *RestoreAdminReq, opts ...grpc.CallOption) (*RestoreAdminResp, error) {
+	out := new(RestoreAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Restore", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CheckAdminFieldReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	Create(context.Context, *Admin) (*Admin, error)
+	Update(context.Context, *Admin) (*Admin, error)
+	Get(context.Context, *GetAdminReq) (*Admin, error)
+	ListAdmins(context.Context, *ListAdminsReq) (*ListAdminsResp, error)
+	Delete(context.Context, *DeleteAdminReq) (*CheckAdminDeleteResp, error)
+	CheckField(context.Context, *CheckAdminFieldReq) (*CheckAdminFieldResp, error)
+	ChangePassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
+	UpdateRefreshToken(context.Context, *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error)
+	VerifyCredentials(context.Context, *VerifyAdminCredentialsReq) (*VerifyAdminCredentialsResp, error)
+	Unlock(context.Context, *UnlockAdminReq) (*UnlockAdminResp, error)
+	RotateRefreshToken(context.Context, *RotateRefreshTokenAdminReq) (*RotateRefreshTokenAdminResp, error)
+	ChangeAdminRole(context.Context, *ChangeAdminRoleReq) (*ChangeAdminRoleResp, error)
+	Restore(context.Context, *RestoreAdminReq) (*RestoreAdminResp, error)
 }
 
-func (m *CheckAdminFieldReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
 }
 
-func (m *CheckAdminFieldResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAdminServiceServer) Create(ctx context.Context, req *Admin) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedAdminServiceServer) Update(ctx context.Context, req *Admin) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedAdminServiceServer) Get(ctx context.Context, req *GetAdminReq) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAdmins(ctx context.Context, req *ListAdminsReq) (*ListAdminsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (*UnimplementedAdminServiceServer) Delete(ctx context.Context, req *DeleteAdminReq) (*CheckAdminDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedAdminServiceServer) CheckField(ctx context.Context, req *CheckAdminFieldReq) (*CheckAdminFieldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+}
+func (*UnimplementedAdminServiceServer) ChangePassword(ctx context.Context, req *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+}
+func (*UnimplementedAdminServiceServer) VerifyCredentials(ctx context.Context, req *VerifyAdminCredentialsReq) (*VerifyAdminCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
+}
+func (*UnimplementedAdminServiceServer) Unlock(ctx context.Context, req *UnlockAdminReq) (*UnlockAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedAdminServiceServer) RotateRefreshToken(ctx context.Context, req *RotateRefreshTokenAdminReq) (*RotateRefreshTokenAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRefreshToken not implemented")
+}
+func (*UnimplementedAdminServiceServer) ChangeAdminRole(ctx context.Context, req *ChangeAdminRoleReq) (*ChangeAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAdminRole not implemented")
+}
+func (*UnimplementedAdminServiceServer) Restore(ctx context.Context, req *RestoreAdminReq) (*RestoreAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
 }
 
-func (m *CheckAdminFieldResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
 }
 
-func (m *CheckAdminFieldResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AdminService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Admin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Create(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Create(ctx, req.(*Admin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckAdminDeleteResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AdminService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Admin)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CheckAdminDeleteResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CheckAdminDeleteResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Update(ctx, in)
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Update",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Update(ctx, req.(*Admin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRefreshTokenAdminReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AdminService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateRefreshTokenAdminReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Get(ctx, req.(*GetAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRefreshTokenAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _AdminService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAdmins(ctx, in)
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListAdmins",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAdmins(ctx, req.(*ListAdminsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRefreshTokenAdminResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _AdminService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateRefreshTokenAdminResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateRefreshTokenAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Delete(ctx, in)
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Delete",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Delete(ctx, req.(*DeleteAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _AdminService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminFieldReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Admin) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckField(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/CheckField",
 	}
-	if m.AdminOrder != 0 {
-		n += 1 + sovAdmin(uint64(m.AdminOrder))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckField(ctx, req.(*CheckAdminFieldReq))
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAdminPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangePassword(ctx, in)
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ChangePassword",
 	}
-	l = len(m.BirthDate)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangePassword(ctx, req.(*ChangeAdminPasswordReq))
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRefreshTokenAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/UpdateRefreshToken",
 	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenAdminReq))
 	}
-	if m.Salary != 0 {
-		n += 5
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAdminCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Biography)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyCredentials(ctx, in)
 	}
-	l = len(m.StartWorkYear)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/VerifyCredentials",
 	}
-	l = len(m.EndWorkYear)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyCredentials(ctx, req.(*VerifyAdminCredentialsReq))
 	}
-	if m.WorkYears != 0 {
-		n += 1 + sovAdmin(uint64(m.WorkYears))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Unlock(ctx, in)
 	}
-	l = len(m.ImageUrl)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Unlock",
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Unlock(ctx, req.(*UnlockAdminReq))
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRefreshTokenAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateRefreshToken(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/RotateRefreshToken",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateRefreshToken(ctx, req.(*RotateRefreshTokenAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetAdminReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+func _AdminService_ChangeAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangeAdminRole(ctx, in)
 	}
-	if m.IsActive {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ChangeAdminRole",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangeAdminRole(ctx, req.(*ChangeAdminRoleReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAdminsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovAdmin(uint64(m.Page))
+func _AdminService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		n += 1 + sovAdmin(uint64(m.Limit))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Restore(ctx, in)
 	}
-	if m.IsActive {
-		n += 2
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Restore",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Restore(ctx, req.(*RestoreAdminReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAdminsResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for _, e := range m.Admins {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovAdmin(uint64(m.Count))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "user.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _AdminService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AdminService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AdminService_Get_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _AdminService_ListAdmins_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AdminService_Delete_Handler,
+		},
+		{
+			MethodName: "CheckField",
+			Handler:    _AdminService_CheckField_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AdminService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "UpdateRefreshToken",
+			Handler:    _AdminService_UpdateRefreshToken_Handler,
+		},
+		{
+			MethodName: "VerifyCredentials",
+			Handler:    _AdminService_VerifyCredentials_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _AdminService_Unlock_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _AdminService_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "ChangeAdminRole",
+			Handler:    _AdminService_ChangeAdminRole_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _AdminService_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user_service/admin.proto",
 }
 
-func (m *ChangeAdminPasswordReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Admin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DeleteAdminReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.IsActive {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *Admin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangeAdminPasswordResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Admin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *CheckAdminFieldReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LockedUntil) > 0 {
+		i -= len(m.LockedUntil)
+		copy(dAtA[i:], m.LockedUntil)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LockedUntil)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.FailedLoginAttempts != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FailedLoginAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
-}
-
-func (m *CheckAdminFieldResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
-}
-
-func (m *CheckAdminDeleteResp) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WorkYears != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.WorkYears))
+		i--
+		dAtA[i] = 0x78
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if len(m.EndWorkYear) > 0 {
+		i -= len(m.EndWorkYear)
+		copy(dAtA[i:], m.EndWorkYear)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EndWorkYear)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.StartWorkYear) > 0 {
+		i -= len(m.StartWorkYear)
+		copy(dAtA[i:], m.StartWorkYear)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.StartWorkYear)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	if len(m.Biography) > 0 {
+		i -= len(m.Biography)
+		copy(dAtA[i:], m.Biography)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Biography)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Salary != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BirthDate) > 0 {
+		i -= len(m.BirthDate)
+		copy(dAtA[i:], m.BirthDate)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BirthDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AdminOrder != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.AdminOrder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateRefreshTokenAdminReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAdminsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAdminsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IsLocked {
+		i--
+		if m.IsLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAdminsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAdminsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAdminsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminPasswordReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminPasswordReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminPasswordResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminPasswordResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAdminFieldReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAdminFieldReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAdminFieldReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAdminFieldResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAdminFieldResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAdminFieldResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAdminDeleteResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAdminDeleteResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAdminDeleteResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyAdminCredentialsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyAdminCredentialsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyAdminCredentialsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyAdminCredentialsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyAdminCredentialsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyAdminCredentialsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LockedUntil) > 0 {
+		i -= len(m.LockedUntil)
+		copy(dAtA[i:], m.LockedUntil)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LockedUntil)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateRefreshTokenAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateRefreshTokenAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateRefreshTokenAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewRefreshToken) > 0 {
+		i -= len(m.NewRefreshToken)
+		copy(dAtA[i:], m.NewRefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.NewRefreshToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateRefreshTokenAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateRefreshTokenAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateRefreshTokenAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReuseDetected {
+		i--
+		if m.ReuseDetected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminRoleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminRoleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminRoleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminRoleResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminRoleResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminRoleResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Admin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.AdminOrder != 0 {
+		n += 1 + sovAdmin(uint64(m.AdminOrder))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.BirthDate)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Salary != 0 {
+		n += 5
+	}
+	l = len(m.Biography)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.StartWorkYear)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.EndWorkYear)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.WorkYears != 0 {
+		n += 1 + sovAdmin(uint64(m.WorkYears))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.FailedLoginAttempts != 0 {
+		n += 2 + sovAdmin(uint64(m.FailedLoginAttempts))
+	}
+	l = len(m.LockedUntil)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAdminsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovAdmin(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IsLocked {
+		n += 2
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAdminsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, e := range m.Admins {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovAdmin(uint64(m.Count))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminPasswordReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminPasswordResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAdminFieldReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAdminFieldResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAdminDeleteResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyAdminCredentialsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyAdminCredentialsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.LockedUntil)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnlockAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnlockAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRefreshTokenAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.NewRefreshToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRefreshTokenAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.ReuseDetected {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminRoleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminRoleResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Admin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Admin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Admin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminOrder", wireType)
+			}
+			m.AdminOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminOrder |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Salary = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biography", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Biography = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartWorkYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndWorkYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+			}
+			m.WorkYears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkYears |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedLoginAttempts", wireType)
+			}
+			m.FailedLoginAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedLoginAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UpdateRefreshTokenAdminResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Admin) Unmarshal(dAtA []byte) error {
+func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2278,15 +4475,15 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Admin: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Admin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2314,13 +4511,45 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			m.AdminOrder = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2330,14 +4559,124 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AdminOrder |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAdminsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2365,11 +4704,43 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2397,11 +4768,31 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLocked = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2429,13 +4820,64 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAdminsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAdminsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2445,29 +4887,31 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			m.Admins = append(m.Admins, &Admin{})
+			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2477,27 +4921,14 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,11 +4956,62 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeAdminPasswordReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeAdminPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2557,11 +5039,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2589,22 +5071,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gender = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Salary = float32(math.Float32frombits(v))
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biography", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2632,11 +5103,62 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Biography = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAdminReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2664,11 +5186,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartWorkYear = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2696,13 +5218,13 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndWorkYear = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
 			}
-			m.WorkYears = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2712,16 +5234,68 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkYears |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeAdminPasswordResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeAdminPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2731,27 +5305,66 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAdminFieldReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAdminFieldReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2779,11 +5392,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2811,13 +5424,64 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAdminFieldResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAdminFieldResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2827,29 +5491,68 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAdminDeleteResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAdminDeleteResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAdminDeleteResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2859,24 +5562,12 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -2899,7 +5590,7 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
+func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2922,15 +5613,15 @@ func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAdminReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2958,11 +5649,11 @@ func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2990,13 +5681,13 @@ func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3006,12 +5697,24 @@ func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3034,7 +5737,7 @@ func (m *GetAdminReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
+func (m *UpdateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,17 +5760,17 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAdminsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Page = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3077,53 +5780,66 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyAdminCredentialsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			m.IsActive = bool(v != 0)
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyAdminCredentialsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyAdminCredentialsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3151,11 +5867,11 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3183,11 +5899,11 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3215,7 +5931,7 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3239,7 +5955,7 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
+func (m *VerifyAdminCredentialsResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3262,17 +5978,17 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAdminsResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyAdminCredentialsResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAdminsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyAdminCredentialsResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3282,31 +5998,17 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admins = append(m.Admins, &Admin{})
-			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3316,65 +6018,27 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeAdminPasswordReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeAdminPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3402,11 +6066,11 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3434,11 +6098,11 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedUntil", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3466,7 +6130,7 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.LockedUntil = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3490,7 +6154,7 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
+func (m *UnlockAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3513,47 +6177,15 @@ func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAdminReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3581,28 +6213,8 @@ func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3625,7 +6237,7 @@ func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
+func (m *UnlockAdminResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,10 +6260,10 @@ func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeAdminPasswordResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockAdminResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeAdminPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3696,7 +6308,7 @@ func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
+func (m *RotateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3719,15 +6331,15 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAdminFieldReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateRefreshTokenAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAdminFieldReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateRefreshTokenAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3755,11 +6367,11 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3787,7 +6399,7 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.NewRefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3811,7 +6423,7 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
+func (m *RotateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,10 +6446,10 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAdminFieldResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateRefreshTokenAdminResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAdminFieldResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateRefreshTokenAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3860,6 +6472,58 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Status = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReuseDetected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReuseDetected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3882,7 +6546,7 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAdminDeleteResp) Unmarshal(dAtA []byte) error {
+func (m *ChangeAdminRoleReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3905,17 +6569,17 @@ func (m *CheckAdminDeleteResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAdminDeleteResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeAdminRoleReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAdminDeleteResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeAdminRoleReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3925,12 +6589,56 @@ func (m *CheckAdminDeleteResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3953,7 +6661,7 @@ func (m *CheckAdminDeleteResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
+func (m *ChangeAdminRoleResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3976,17 +6684,17 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeAdminRoleResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeAdminRoleResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3996,27 +6704,66 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreAdminReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreAdminReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4044,7 +6791,7 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4068,7 +6815,7 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
+func (m *RestoreAdminResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4091,10 +6838,10 @@ func (m *UpdateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreAdminResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -40,6 +40,8 @@ type User struct {
 	CreatedAt            string   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	UpdatedAt            string   `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	DeletedAt            string   `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	FailedLoginAttempts  uint64   `protobuf:"varint,14,opt,name=failed_login_attempts,json=failedLoginAttempts,proto3" json:"failed_login_attempts"`
+	LockedUntil          string   `protobuf:"bytes,15,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -169,6 +171,20 @@ func (m *User) GetDeletedAt() string {
 	return ""
 }
 
+func (m *User) GetFailedLoginAttempts() uint64 {
+	if m != nil {
+		return m.FailedLoginAttempts
+	}
+	return 0
+}
+
+func (m *User) GetLockedUntil() string {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return ""
+}
+
 type CheckFieldUserReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
@@ -487,6 +503,7 @@ type DeleteUserReq struct {
 	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
 	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Mode                 string   `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -546,16 +563,26 @@ func (m *DeleteUserReq) GetIsActive() bool {
 	return false
 }
 
+func (m *DeleteUserReq) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
 type ListUsersReq struct {
-	Page                 uint64   `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
-	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
-	IsActive             bool     `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
-	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
-	Field                string   `protobuf:"bytes,5,opt,name=field,proto3" json:"field"`
-	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page                 uint64      `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Limit                uint64      `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	IsActive             bool        `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active"`
+	Value                string      `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
+	Field                string      `protobuf:"bytes,5,opt,name=field,proto3" json:"field"`
+	OrderBy              string      `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by"`
+	IsLocked             bool        `protobuf:"varint,7,opt,name=is_locked,json=isLocked,proto3" json:"is_locked"`
+	Filter               *UserFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter"`
+	PageToken            string      `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *ListUsersReq) Reset()         { *m = ListUsersReq{} }
@@ -633,9 +660,31 @@ func (m *ListUsersReq) GetOrderBy() string {
 	return ""
 }
 
+func (m *ListUsersReq) GetIsLocked() bool {
+	if m != nil {
+		return m.IsLocked
+	}
+	return false
+}
+
+func (m *ListUsersReq) GetFilter() *UserFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListUsersReq) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type ListUsersResp struct {
 	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
 	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -688,6 +737,13 @@ func (m *ListUsersResp) GetCount() uint64 {
 	return 0
 }
 
+func (m *ListUsersResp) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -730,6 +786,7 @@ var xxx_messageInfo_Empty proto.InternalMessageInfo
 type UpdateRefreshTokenUserReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
 	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	DeviceId             string   `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -782,6 +839,13 @@ func (m *UpdateRefreshTokenUserReq) GetRefreshToken() string {
 	return ""
 }
 
+func (m *UpdateRefreshTokenUserReq) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
 type UpdateRefreshTokenUserResp struct {
 	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -829,1379 +893,4751 @@ func (m *UpdateRefreshTokenUserResp) GetStatus() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*User)(nil), "user.User")
-	proto.RegisterType((*CheckFieldUserReq)(nil), "user.CheckFieldUserReq")
-	proto.RegisterType((*CheckFieldUserResp)(nil), "user.CheckFieldUserResp")
-	proto.RegisterType((*CheckDeleteUserResp)(nil), "user.CheckDeleteUserResp")
-	proto.RegisterType((*GetUserReq)(nil), "user.GetUserReq")
-	proto.RegisterType((*ChangeUserPasswordReq)(nil), "user.ChangeUserPasswordReq")
-	proto.RegisterType((*ChangeUserPasswordResp)(nil), "user.ChangeUserPasswordResp")
-	proto.RegisterType((*DeleteUserReq)(nil), "user.DeleteUserReq")
-	proto.RegisterType((*ListUsersReq)(nil), "user.ListUsersReq")
-	proto.RegisterType((*ListUsersResp)(nil), "user.ListUsersResp")
-	proto.RegisterType((*Empty)(nil), "user.Empty")
-	proto.RegisterType((*UpdateRefreshTokenUserReq)(nil), "user.UpdateRefreshTokenUserReq")
-	proto.RegisterType((*UpdateRefreshTokenUserResp)(nil), "user.UpdateRefreshTokenUserResp")
+type VerifyUserCredentialsReq struct {
+	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("user_service/user.proto", fileDescriptor_749038872b9165fb) }
-
-var fileDescriptor_749038872b9165fb = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xfe, 0x39, 0x71, 0x52, 0x7b, 0x92, 0x54, 0xfd, 0x6d, 0xa1, 0x75, 0x5d, 0x1a, 0x82, 0x7b,
-	0xe9, 0x01, 0x0a, 0x2a, 0x3d, 0x70, 0xab, 0xfa, 0x07, 0x7a, 0x00, 0x95, 0xca, 0x10, 0xe0, 0x66,
-	0x6d, 0xe2, 0x6d, 0x62, 0xd5, 0xb1, 0xcd, 0xee, 0xa6, 0xa8, 0x2f, 0xc0, 0x33, 0x70, 0xe0, 0x81,
-	0xb8, 0x20, 0xf1, 0x08, 0xa8, 0xbc, 0x08, 0xda, 0x59, 0xa7, 0x75, 0xf3, 0x0f, 0x09, 0x71, 0xf3,
-	0x7c, 0xdf, 0x78, 0xfc, 0xed, 0xcc, 0x7c, 0x6b, 0x58, 0x1d, 0x0a, 0xc6, 0x03, 0xc1, 0xf8, 0x45,
-	0xd4, 0x65, 0x8f, 0x55, 0xb0, 0x9d, 0xf1, 0x54, 0xa6, 0xc4, 0x54, 0xcf, 0xde, 0xe7, 0x32, 0x98,
-	0x6d, 0xc1, 0x38, 0x59, 0x84, 0x52, 0x14, 0x3a, 0x46, 0xcb, 0xd8, 0xb2, 0xfd, 0x52, 0x14, 0x92,
-	0x0d, 0x00, 0x7c, 0x33, 0xe5, 0x21, 0xe3, 0x4e, 0xa9, 0x65, 0x6c, 0x99, 0xbe, 0xad, 0x90, 0xd7,
-	0x0a, 0x50, 0xf4, 0x59, 0xc4, 0x85, 0x0c, 0x12, 0x3a, 0x60, 0x4e, 0x19, 0x5f, 0xb3, 0x11, 0x39,
-	0xa1, 0x03, 0x46, 0xd6, 0xc1, 0x8e, 0xe9, 0x88, 0x35, 0x91, 0xb5, 0x14, 0x80, 0xe4, 0x06, 0x40,
-	0x27, 0xe2, 0xb2, 0x1f, 0x84, 0x54, 0x32, 0xa7, 0xa2, 0xdf, 0x45, 0xe4, 0x88, 0x4a, 0x46, 0x1e,
-	0x40, 0x3d, 0xeb, 0xa7, 0x09, 0x0b, 0x92, 0xe1, 0xa0, 0xc3, 0xb8, 0x53, 0xc5, 0x84, 0x1a, 0x62,
-	0x27, 0x08, 0x11, 0x17, 0xac, 0x8c, 0x0a, 0xf1, 0x29, 0xe5, 0xa1, 0xb3, 0xa0, 0xab, 0x8f, 0x62,
-	0xb2, 0x02, 0xd5, 0x1e, 0x4b, 0x94, 0x68, 0x0b, 0x99, 0x3c, 0x22, 0x9b, 0xd0, 0xe0, 0xec, 0x8c,
-	0x33, 0xd1, 0x0f, 0x64, 0x7a, 0xce, 0x12, 0xc7, 0x46, 0xba, 0x9e, 0x83, 0x6f, 0x15, 0xa6, 0x74,
-	0x47, 0x03, 0xda, 0x63, 0xc1, 0x90, 0xc7, 0x0e, 0xe8, 0xca, 0x08, 0xb4, 0x79, 0xac, 0x74, 0x77,
-	0x39, 0xa3, 0x92, 0x85, 0x01, 0x95, 0x4e, 0x4d, 0xeb, 0xce, 0x91, 0x7d, 0x89, 0x1d, 0xcb, 0xc2,
-	0x11, 0x5d, 0xd7, 0x74, 0x8e, 0x68, 0x3a, 0x64, 0x31, 0xcb, 0xe9, 0x86, 0xa6, 0x73, 0x64, 0x5f,
-	0x7a, 0x7b, 0xf0, 0xff, 0x61, 0x9f, 0x75, 0xcf, 0x5f, 0x44, 0x2c, 0x0e, 0xd5, 0x44, 0x7c, 0xf6,
-	0x91, 0xdc, 0x81, 0xca, 0x99, 0x8a, 0xf3, 0xb9, 0xe8, 0x40, 0xa1, 0x17, 0x34, 0x1e, 0x32, 0x9c,
-	0x8a, 0xed, 0xeb, 0xc0, 0x7b, 0x08, 0x64, 0xbc, 0x80, 0xc8, 0x54, 0x37, 0x84, 0xa4, 0x72, 0x28,
-	0xb0, 0x84, 0xe5, 0xe7, 0x91, 0xf7, 0x08, 0x96, 0x31, 0xfb, 0x08, 0x05, 0xfc, 0x31, 0xbd, 0x0d,
-	0x70, 0xcc, 0xe4, 0x5f, 0xc8, 0xc2, 0x8e, 0x8a, 0x80, 0x76, 0x65, 0x74, 0xa1, 0xf7, 0xc4, 0xf2,
-	0xad, 0x48, 0xec, 0x63, 0xec, 0xbd, 0x83, 0xbb, 0x87, 0x7d, 0x9a, 0xf4, 0x50, 0xc0, 0x69, 0x3e,
-	0x41, 0xf5, 0x85, 0xf1, 0x1d, 0x30, 0xe6, 0xef, 0x40, 0xe9, 0xf6, 0x0e, 0x78, 0x4f, 0x60, 0x65,
-	0x5a, 0xdd, 0x39, 0x07, 0xfc, 0x00, 0x8d, 0x62, 0x2b, 0xfe, 0xe1, 0x19, 0xbf, 0x1a, 0x50, 0x7f,
-	0x15, 0x09, 0x6c, 0x9e, 0x50, 0x95, 0x09, 0x98, 0x19, 0xed, 0x31, 0x2c, 0x6c, 0xfa, 0xf8, 0xac,
-	0xea, 0xc6, 0xd1, 0x20, 0x92, 0xb9, 0xd1, 0x74, 0x30, 0xb7, 0xee, 0x8d, 0x14, 0xb3, 0x28, 0xe5,
-	0x5a, 0x76, 0xa5, 0x28, 0x7b, 0x0d, 0x2c, 0xf4, 0x71, 0xd0, 0xb9, 0xcc, 0xed, 0xb4, 0x80, 0xf1,
-	0xc1, 0xa5, 0x77, 0x0c, 0x8d, 0x82, 0x3a, 0x91, 0x91, 0x16, 0x54, 0x94, 0xcd, 0x55, 0x83, 0xca,
-	0x5b, 0xb5, 0x1d, 0xd8, 0xc6, 0x3b, 0x03, 0xdb, 0xa2, 0x09, 0xf5, 0x8d, 0x6e, 0x3a, 0x4c, 0xae,
-	0xc5, 0x62, 0xe0, 0x2d, 0x40, 0xe5, 0xf9, 0x20, 0x93, 0x97, 0xde, 0x29, 0xac, 0xb5, 0x71, 0xeb,
-	0xfd, 0x82, 0xb3, 0x46, 0x6d, 0x1d, 0xbf, 0x66, 0x26, 0x5c, 0x59, 0x9a, 0x74, 0xa5, 0xb7, 0x0b,
-	0xee, 0xac, 0x8a, 0xb3, 0x47, 0xba, 0xf3, 0xbd, 0x0c, 0x35, 0x95, 0xf4, 0x46, 0xdf, 0x7d, 0xa4,
-	0x05, 0xd5, 0x43, 0x34, 0x2b, 0x29, 0x9c, 0xc9, 0x2d, 0x3c, 0xab, 0x0c, 0xfd, 0x9d, 0x99, 0x19,
-	0x9b, 0x50, 0x3e, 0x66, 0x92, 0x2c, 0x69, 0xe8, 0xc6, 0x12, 0xb7, 0x92, 0x76, 0xc1, 0xbe, 0x6e,
-	0x29, 0x21, 0x9a, 0x28, 0x6e, 0x80, 0xbb, 0x3c, 0x81, 0x89, 0x8c, 0x3c, 0x83, 0xaa, 0xde, 0x40,
-	0x92, 0xd3, 0xb7, 0xf6, 0xd1, 0x5d, 0xd3, 0xe0, 0x34, 0xd3, 0xee, 0x01, 0xdc, 0x38, 0x9f, 0xac,
-	0x16, 0x12, 0x8b, 0x97, 0x89, 0xeb, 0x4c, 0x27, 0x44, 0x46, 0x5e, 0xc2, 0xa2, 0xb6, 0xcb, 0xc8,
-	0x2a, 0x64, 0x7d, 0x94, 0x3b, 0xc5, 0x9c, 0xee, 0xbd, 0xd9, 0xa4, 0xc8, 0xc8, 0x7b, 0x20, 0x93,
-	0xc3, 0x22, 0xf7, 0xf3, 0xfe, 0xcc, 0x5a, 0x0c, 0xb7, 0x35, 0x3f, 0x41, 0x64, 0x07, 0x4b, 0xdf,
-	0xae, 0x9a, 0xc6, 0x8f, 0xab, 0xa6, 0xf1, 0xf3, 0xaa, 0x69, 0x7c, 0xf9, 0xd5, 0xfc, 0xaf, 0x53,
-	0xc5, 0x3f, 0xd9, 0xd3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0xa5, 0xe8, 0x98, 0xe4, 0x06,
-	0x00, 0x00,
+func (m *VerifyUserCredentialsReq) Reset()         { *m = VerifyUserCredentialsReq{} }
+func (m *VerifyUserCredentialsReq) String() string { return proto.CompactTextString(m) }
+func (*VerifyUserCredentialsReq) ProtoMessage()    {}
+func (*VerifyUserCredentialsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{13}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// UserServiceClient is the client API for UserService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserServiceClient interface {
-	Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
-	Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
-	Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error)
-	ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error)
-	Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*CheckDeleteUserResp, error)
-	CheckField(ctx context.Context, in *CheckFieldUserReq, opts ...grpc.CallOption) (*CheckFieldUserResp, error)
-	ChangePassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*ChangeUserPasswordResp, error)
-	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenUserReq, opts ...grpc.CallOption) (*UpdateRefreshTokenUserResp, error)
+func (m *VerifyUserCredentialsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type userServiceClient struct {
-	cc *grpc.ClientConn
+func (m *VerifyUserCredentialsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyUserCredentialsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewUserServiceClient(cc *grpc.ClientConn) UserServiceClient {
-	return &userServiceClient{cc}
+func (m *VerifyUserCredentialsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyUserCredentialsReq.Merge(m, src)
+}
+func (m *VerifyUserCredentialsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyUserCredentialsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyUserCredentialsReq.DiscardUnknown(m)
 }
 
-func (c *userServiceClient) Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/user.UserService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyUserCredentialsReq proto.InternalMessageInfo
+
+func (m *VerifyUserCredentialsReq) GetPhoneNumber() string {
+	if m != nil {
+		return m.PhoneNumber
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/user.UserService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyUserCredentialsReq) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/user.UserService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type VerifyUserCredentialsResp struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status"`
+	LockedUntil          string   `protobuf:"bytes,5,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error) {
-	out := new(ListUsersResp)
-	err := c.cc.Invoke(ctx, "/user.UserService/ListUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyUserCredentialsResp) Reset()         { *m = VerifyUserCredentialsResp{} }
+func (m *VerifyUserCredentialsResp) String() string { return proto.CompactTextString(m) }
+func (*VerifyUserCredentialsResp) ProtoMessage()    {}
+func (*VerifyUserCredentialsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{14}
+}
+func (m *VerifyUserCredentialsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyUserCredentialsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyUserCredentialsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *VerifyUserCredentialsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyUserCredentialsResp.Merge(m, src)
+}
+func (m *VerifyUserCredentialsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyUserCredentialsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyUserCredentialsResp.DiscardUnknown(m)
 }
 
-func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*CheckDeleteUserResp, error) {
-	out := new(CheckDeleteUserResp)
-	err := c.cc.Invoke(ctx, "/user.UserService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyUserCredentialsResp proto.InternalMessageInfo
+
+func (m *VerifyUserCredentialsResp) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return out, nil
+	return false
 }
 
-func (c *userServiceClient) CheckField(ctx context.Context, in *CheckFieldUserReq, opts ...grpc.CallOption) (*CheckFieldUserResp, error) {
-	out := new(CheckFieldUserResp)
-	err := c.cc.Invoke(ctx, "/user.UserService/CheckField", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyUserCredentialsResp) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*ChangeUserPasswordResp, error) {
-	out := new(ChangeUserPasswordResp)
-	err := c.cc.Invoke(ctx, "/user.UserService/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyUserCredentialsResp) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenUserReq, opts ...grpc.CallOption) (*UpdateRefreshTokenUserResp, error) {
-	out := new(UpdateRefreshTokenUserResp)
-	err := c.cc.Invoke(ctx, "/user.UserService/UpdateRefreshToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VerifyUserCredentialsResp) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return ""
 }
 
-// UserServiceServer is the server API for UserService service.
-type UserServiceServer interface {
-	Create(context.Context, *User) (*User, error)
-	Update(context.Context, *User) (*User, error)
-	Get(context.Context, *GetUserReq) (*User, error)
-	ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error)
-	Delete(context.Context, *DeleteUserReq) (*CheckDeleteUserResp, error)
-	CheckField(context.Context, *CheckFieldUserReq) (*CheckFieldUserResp, error)
-	ChangePassword(context.Context, *ChangeUserPasswordReq) (*ChangeUserPasswordResp, error)
-	UpdateRefreshToken(context.Context, *UpdateRefreshTokenUserReq) (*UpdateRefreshTokenUserResp, error)
+func (m *VerifyUserCredentialsResp) GetLockedUntil() string {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return ""
 }
 
-// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+type UnlockUserReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedUserServiceServer) Create(ctx context.Context, req *User) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (*UnimplementedUserServiceServer) Update(ctx context.Context, req *User) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (m *UnlockUserReq) Reset()         { *m = UnlockUserReq{} }
+func (m *UnlockUserReq) String() string { return proto.CompactTextString(m) }
+func (*UnlockUserReq) ProtoMessage()    {}
+func (*UnlockUserReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{15}
 }
-func (*UnimplementedUserServiceServer) Get(ctx context.Context, req *GetUserReq) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *UnlockUserReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedUserServiceServer) ListUsers(ctx context.Context, req *ListUsersReq) (*ListUsersResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+func (m *UnlockUserReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockUserReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedUserServiceServer) Delete(ctx context.Context, req *DeleteUserReq) (*CheckDeleteUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *UnlockUserReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUserReq.Merge(m, src)
 }
-func (*UnimplementedUserServiceServer) CheckField(ctx context.Context, req *CheckFieldUserReq) (*CheckFieldUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+func (m *UnlockUserReq) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedUserServiceServer) ChangePassword(ctx context.Context, req *ChangeUserPasswordReq) (*ChangeUserPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+func (m *UnlockUserReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUserReq.DiscardUnknown(m)
 }
-func (*UnimplementedUserServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenUserReq) (*UpdateRefreshTokenUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+
+var xxx_messageInfo_UnlockUserReq proto.InternalMessageInfo
+
+func (m *UnlockUserReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
-	s.RegisterService(&_UserService_serviceDesc, srv)
+type UnlockUserResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Create(ctx, req.(*User))
+func (m *UnlockUserResp) Reset()         { *m = UnlockUserResp{} }
+func (m *UnlockUserResp) String() string { return proto.CompactTextString(m) }
+func (*UnlockUserResp) ProtoMessage()    {}
+func (*UnlockUserResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{16}
+}
+func (m *UnlockUserResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockUserResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockUserResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UnlockUserResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUserResp.Merge(m, src)
+}
+func (m *UnlockUserResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockUserResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUserResp.DiscardUnknown(m)
 }
 
-func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Update(ctx, req.(*User))
+var xxx_messageInfo_UnlockUserResp proto.InternalMessageInfo
+
+func (m *UnlockUserResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserReq)
-	if err := dec(in); err != nil {
-		return nil, err
+type RotateRefreshTokenUserReq struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	NewRefreshToken      string   `protobuf:"bytes,2,opt,name=new_refresh_token,json=newRefreshToken,proto3" json:"new_refresh_token"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateRefreshTokenUserReq) Reset()         { *m = RotateRefreshTokenUserReq{} }
+func (m *RotateRefreshTokenUserReq) String() string { return proto.CompactTextString(m) }
+func (*RotateRefreshTokenUserReq) ProtoMessage()    {}
+func (*RotateRefreshTokenUserReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{17}
+}
+func (m *RotateRefreshTokenUserReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateRefreshTokenUserReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateRefreshTokenUserReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).Get(ctx, in)
+}
+func (m *RotateRefreshTokenUserReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRefreshTokenUserReq.Merge(m, src)
+}
+func (m *RotateRefreshTokenUserReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateRefreshTokenUserReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRefreshTokenUserReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateRefreshTokenUserReq proto.InternalMessageInfo
+
+func (m *RotateRefreshTokenUserReq) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/Get",
+	return ""
+}
+
+func (m *RotateRefreshTokenUserReq) GetNewRefreshToken() string {
+	if m != nil {
+		return m.NewRefreshToken
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Get(ctx, req.(*GetUserReq))
+	return ""
+}
+
+type RotateRefreshTokenUserResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	ReuseDetected        bool     `protobuf:"varint,3,opt,name=reuse_detected,json=reuseDetected,proto3" json:"reuse_detected"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateRefreshTokenUserResp) Reset()         { *m = RotateRefreshTokenUserResp{} }
+func (m *RotateRefreshTokenUserResp) String() string { return proto.CompactTextString(m) }
+func (*RotateRefreshTokenUserResp) ProtoMessage()    {}
+func (*RotateRefreshTokenUserResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{18}
+}
+func (m *RotateRefreshTokenUserResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateRefreshTokenUserResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateRefreshTokenUserResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RotateRefreshTokenUserResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRefreshTokenUserResp.Merge(m, src)
+}
+func (m *RotateRefreshTokenUserResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateRefreshTokenUserResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRefreshTokenUserResp.DiscardUnknown(m)
 }
 
-func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersReq)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RotateRefreshTokenUserResp proto.InternalMessageInfo
+
+func (m *RotateRefreshTokenUserResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).ListUsers(ctx, in)
+	return false
+}
+
+func (m *RotateRefreshTokenUserResp) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/ListUsers",
+	return ""
+}
+
+func (m *RotateRefreshTokenUserResp) GetReuseDetected() bool {
+	if m != nil {
+		return m.ReuseDetected
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersReq))
+	return false
+}
+
+type UserFilter struct {
+	Gender               string   `protobuf:"bytes,1,opt,name=gender,proto3" json:"gender"`
+	BirthDateFrom        string   `protobuf:"bytes,2,opt,name=birth_date_from,json=birthDateFrom,proto3" json:"birth_date_from"`
+	BirthDateTo          string   `protobuf:"bytes,3,opt,name=birth_date_to,json=birthDateTo,proto3" json:"birth_date_to"`
+	AgeFrom              uint32   `protobuf:"varint,4,opt,name=age_from,json=ageFrom,proto3" json:"age_from"`
+	AgeTo                uint32   `protobuf:"varint,5,opt,name=age_to,json=ageTo,proto3" json:"age_to"`
+	CreatedFrom          string   `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from"`
+	CreatedTo            string   `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to"`
+	DeletedState         string   `protobuf:"bytes,8,opt,name=deleted_state,json=deletedState,proto3" json:"deleted_state"`
+	Name                 string   `protobuf:"bytes,9,opt,name=name,proto3" json:"name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserFilter) Reset()         { *m = UserFilter{} }
+func (m *UserFilter) String() string { return proto.CompactTextString(m) }
+func (*UserFilter) ProtoMessage()    {}
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{19}
+}
+func (m *UserFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UserFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFilter.Merge(m, src)
+}
+func (m *UserFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFilter.DiscardUnknown(m)
 }
 
-func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserReq)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_UserFilter proto.InternalMessageInfo
+
+func (m *UserFilter) GetGender() string {
+	if m != nil {
+		return m.Gender
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).Delete(ctx, in)
+	return ""
+}
+
+func (m *UserFilter) GetBirthDateFrom() string {
+	if m != nil {
+		return m.BirthDateFrom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/Delete",
+	return ""
+}
+
+func (m *UserFilter) GetBirthDateTo() string {
+	if m != nil {
+		return m.BirthDateTo
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Delete(ctx, req.(*DeleteUserReq))
+	return ""
+}
+
+func (m *UserFilter) GetAgeFrom() uint32 {
+	if m != nil {
+		return m.AgeFrom
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _UserService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckFieldUserReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UserFilter) GetAgeTo() uint32 {
+	if m != nil {
+		return m.AgeTo
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).CheckField(ctx, in)
+	return 0
+}
+
+func (m *UserFilter) GetCreatedFrom() string {
+	if m != nil {
+		return m.CreatedFrom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/CheckField",
+	return ""
+}
+
+func (m *UserFilter) GetCreatedTo() string {
+	if m != nil {
+		return m.CreatedTo
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CheckField(ctx, req.(*CheckFieldUserReq))
+	return ""
+}
+
+func (m *UserFilter) GetDeletedState() string {
+	if m != nil {
+		return m.DeletedState
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeUserPasswordReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *UserFilter) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	return ""
+}
+
+type SearchUsersReq struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchUsersReq) Reset()         { *m = SearchUsersReq{} }
+func (m *SearchUsersReq) String() string { return proto.CompactTextString(m) }
+func (*SearchUsersReq) ProtoMessage()    {}
+func (*SearchUsersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{20}
+}
+func (m *SearchUsersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchUsersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchUsersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/ChangePassword",
+}
+func (m *SearchUsersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchUsersReq.Merge(m, src)
+}
+func (m *SearchUsersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchUsersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchUsersReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchUsersReq proto.InternalMessageInfo
+
+func (m *SearchUsersReq) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangeUserPasswordReq))
+	return ""
+}
+
+func (m *SearchUsersReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _UserService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRefreshTokenUserReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateRefreshToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.UserService/UpdateRefreshToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenUserReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type SearchUsersResp struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var _UserService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "user.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _UserService_Create_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _UserService_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _UserService_Get_Handler,
-		},
-		{
-			MethodName: "ListUsers",
-			Handler:    _UserService_ListUsers_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _UserService_Delete_Handler,
-		},
-		{
-			MethodName: "CheckField",
-			Handler:    _UserService_CheckField_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _UserService_ChangePassword_Handler,
-		},
-		{
-			MethodName: "UpdateRefreshToken",
-			Handler:    _UserService_UpdateRefreshToken_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "user_service/user.proto",
+func (m *SearchUsersResp) Reset()         { *m = SearchUsersResp{} }
+func (m *SearchUsersResp) String() string { return proto.CompactTextString(m) }
+func (*SearchUsersResp) ProtoMessage()    {}
+func (*SearchUsersResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{21}
 }
-
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SearchUsersResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchUsersResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchUsersResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SearchUsersResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchUsersResp.Merge(m, src)
 }
-
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.ImageUrl) > 0 {
-		i -= len(m.ImageUrl)
-		copy(dAtA[i:], m.ImageUrl)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.ImageUrl)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Gender) > 0 {
-		i -= len(m.Gender)
-		copy(dAtA[i:], m.Gender)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Gender)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.BirthDate) > 0 {
-		i -= len(m.BirthDate)
-		copy(dAtA[i:], m.BirthDate)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.BirthDate)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.UserOrder != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.UserOrder))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *SearchUsersResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchUsersResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchUsersResp.DiscardUnknown(m)
 }
 
-func (m *CheckFieldUserReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SearchUsersResp proto.InternalMessageInfo
+
+func (m *SearchUsersResp) GetUsers() []*User {
+	if m != nil {
+		return m.Users
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckFieldUserReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestoreUserReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckFieldUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+func (m *RestoreUserReq) Reset()         { *m = RestoreUserReq{} }
+func (m *RestoreUserReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserReq) ProtoMessage()    {}
+func (*RestoreUserReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{22}
+}
+func (m *RestoreUserReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreUserReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreUserReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RestoreUserReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserReq.Merge(m, src)
+}
+func (m *RestoreUserReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreUserReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserReq.DiscardUnknown(m)
 }
 
-func (m *CheckFieldUserResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RestoreUserReq proto.InternalMessageInfo
+
+func (m *RestoreUserReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CheckFieldUserResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RestoreUserResp struct {
+	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckFieldUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RestoreUserResp) Reset()         { *m = RestoreUserResp{} }
+func (m *RestoreUserResp) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResp) ProtoMessage()    {}
+func (*RestoreUserResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{23}
+}
+func (m *RestoreUserResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreUserResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreUserResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RestoreUserResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResp.Merge(m, src)
+}
+func (m *RestoreUserResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreUserResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResp.DiscardUnknown(m)
 }
 
-func (m *CheckDeleteUserResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RestoreUserResp proto.InternalMessageInfo
+
+func (m *RestoreUserResp) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *CheckDeleteUserResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ExportUserDataReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	IncludeBooking       bool     `protobuf:"varint,2,opt,name=include_booking,json=includeBooking,proto3" json:"include_booking"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckDeleteUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ExportUserDataReq) Reset()         { *m = ExportUserDataReq{} }
+func (m *ExportUserDataReq) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataReq) ProtoMessage()    {}
+func (*ExportUserDataReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{24}
+}
+func (m *ExportUserDataReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportUserDataReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportUserDataReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExportUserDataReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataReq.Merge(m, src)
+}
+func (m *ExportUserDataReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportUserDataReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataReq.DiscardUnknown(m)
 }
 
-func (m *GetUserReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExportUserDataReq proto.InternalMessageInfo
+
+func (m *ExportUserDataReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetUserReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExportUserDataReq) GetIncludeBooking() bool {
+	if m != nil {
+		return m.IncludeBooking
+	}
+	return false
 }
 
-func (m *GetUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type ExportUserDataResp struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataResp) Reset()         { *m = ExportUserDataResp{} }
+func (m *ExportUserDataResp) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataResp) ProtoMessage()    {}
+func (*ExportUserDataResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_749038872b9165fb, []int{25}
+}
+func (m *ExportUserDataResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportUserDataResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportUserDataResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExportUserDataResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataResp.Merge(m, src)
+}
+func (m *ExportUserDataResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportUserDataResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataResp.DiscardUnknown(m)
 }
 
-func (m *ChangeUserPasswordReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExportUserDataResp proto.InternalMessageInfo
+
+func (m *ExportUserDataResp) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChangeUserPasswordReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*User)(nil), "user.User")
+	proto.RegisterType((*CheckFieldUserReq)(nil), "user.CheckFieldUserReq")
+	proto.RegisterType((*CheckFieldUserResp)(nil), "user.CheckFieldUserResp")
+	proto.RegisterType((*CheckDeleteUserResp)(nil), "user.CheckDeleteUserResp")
+	proto.RegisterType((*GetUserReq)(nil), "user.GetUserReq")
+	proto.RegisterType((*ChangeUserPasswordReq)(nil), "user.ChangeUserPasswordReq")
+	proto.RegisterType((*ChangeUserPasswordResp)(nil), "user.ChangeUserPasswordResp")
+	proto.RegisterType((*DeleteUserReq)(nil), "user.DeleteUserReq")
+	proto.RegisterType((*ListUsersReq)(nil), "user.ListUsersReq")
+	proto.RegisterType((*ListUsersResp)(nil), "user.ListUsersResp")
+	proto.RegisterType((*Empty)(nil), "user.Empty")
+	proto.RegisterType((*UpdateRefreshTokenUserReq)(nil), "user.UpdateRefreshTokenUserReq")
+	proto.RegisterType((*UpdateRefreshTokenUserResp)(nil), "user.UpdateRefreshTokenUserResp")
+	proto.RegisterType((*VerifyUserCredentialsReq)(nil), "user.VerifyUserCredentialsReq")
+	proto.RegisterType((*VerifyUserCredentialsResp)(nil), "user.VerifyUserCredentialsResp")
+	proto.RegisterType((*UnlockUserReq)(nil), "user.UnlockUserReq")
+	proto.RegisterType((*UnlockUserResp)(nil), "user.UnlockUserResp")
+	proto.RegisterType((*RotateRefreshTokenUserReq)(nil), "user.RotateRefreshTokenUserReq")
+	proto.RegisterType((*RotateRefreshTokenUserResp)(nil), "user.RotateRefreshTokenUserResp")
+	proto.RegisterType((*UserFilter)(nil), "user.UserFilter")
+	proto.RegisterType((*SearchUsersReq)(nil), "user.SearchUsersReq")
+	proto.RegisterType((*SearchUsersResp)(nil), "user.SearchUsersResp")
+	proto.RegisterType((*RestoreUserReq)(nil), "user.RestoreUserReq")
+	proto.RegisterType((*RestoreUserResp)(nil), "user.RestoreUserResp")
+	proto.RegisterType((*ExportUserDataReq)(nil), "user.ExportUserDataReq")
+	proto.RegisterType((*ExportUserDataResp)(nil), "user.ExportUserDataResp")
 }
 
-func (m *ChangeUserPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("user_service/user.proto", fileDescriptor_749038872b9165fb) }
+
+var fileDescriptor_749038872b9165fb = []byte{
+	// 1293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0xfe, 0x25, 0xeb, 0x38, 0x3a, 0xc5, 0x9b, 0x38, 0xa1, 0x99, 0x3f, 0x8e, 0xca, 0xa0, 0xa9,
+	0x1b, 0xb4, 0x69, 0x60, 0x07, 0x45, 0x51, 0x14, 0x08, 0x7c, 0x0c, 0x82, 0x1a, 0x69, 0x40, 0x5b,
+	0x29, 0x7a, 0x45, 0xd0, 0xe2, 0x48, 0x22, 0x4c, 0x91, 0xcc, 0xee, 0xca, 0x8e, 0x6f, 0xfb, 0x04,
+	0xbd, 0xec, 0x4d, 0xdf, 0xa7, 0x97, 0x7d, 0x81, 0x02, 0x85, 0x7b, 0xdb, 0x87, 0x28, 0xf6, 0x40,
+	0x99, 0xb4, 0x48, 0xb5, 0x28, 0x72, 0xc7, 0xf9, 0x66, 0x76, 0x67, 0x77, 0x0e, 0xdf, 0x2c, 0xe1,
+	0xde, 0x8c, 0x21, 0x75, 0x18, 0xd2, 0x73, 0x7f, 0x88, 0x5f, 0x08, 0xe1, 0x69, 0x4c, 0x23, 0x1e,
+	0x91, 0x8a, 0xf8, 0xb6, 0x7e, 0x5f, 0x81, 0xca, 0x80, 0x21, 0x25, 0x5d, 0x28, 0xfb, 0x9e, 0x51,
+	0xea, 0x97, 0x36, 0x9b, 0x76, 0xd9, 0xf7, 0xc8, 0x03, 0x00, 0xb9, 0x32, 0xa2, 0x1e, 0x52, 0xa3,
+	0xdc, 0x2f, 0x6d, 0x56, 0xec, 0xa6, 0x40, 0xbe, 0x13, 0x80, 0x50, 0x8f, 0x7c, 0xca, 0xb8, 0x13,
+	0xba, 0x53, 0x34, 0x56, 0xe4, 0xb2, 0xa6, 0x44, 0x5e, 0xbb, 0x53, 0x24, 0xf7, 0xa1, 0x19, 0xb8,
+	0x89, 0xb6, 0x22, 0xb5, 0x8d, 0xc0, 0xd5, 0xca, 0x07, 0x00, 0xa7, 0x3e, 0xe5, 0x13, 0xc7, 0x73,
+	0x39, 0x1a, 0x55, 0xb5, 0x56, 0x22, 0xfb, 0x2e, 0x47, 0xf2, 0x11, 0xb4, 0xe3, 0x49, 0x14, 0xa2,
+	0x13, 0xce, 0xa6, 0xa7, 0x48, 0x8d, 0x9a, 0x34, 0x68, 0x49, 0xec, 0xb5, 0x84, 0x88, 0x09, 0x8d,
+	0xd8, 0x65, 0xec, 0x22, 0xa2, 0x9e, 0x51, 0x57, 0xbb, 0x27, 0x32, 0xb9, 0x0b, 0xb5, 0x31, 0x86,
+	0xe2, 0xd0, 0x0d, 0xa9, 0xd1, 0x12, 0x79, 0x04, 0x1d, 0x8a, 0x23, 0x8a, 0x6c, 0xe2, 0xf0, 0xe8,
+	0x0c, 0x43, 0xa3, 0x29, 0xd5, 0x6d, 0x0d, 0x9e, 0x08, 0x4c, 0x9c, 0xdb, 0x9f, 0xba, 0x63, 0x74,
+	0x66, 0x34, 0x30, 0x40, 0xed, 0x2c, 0x81, 0x01, 0x0d, 0xc4, 0xb9, 0x87, 0x14, 0x5d, 0x8e, 0x9e,
+	0xe3, 0x72, 0xa3, 0xa5, 0xce, 0xad, 0x91, 0x1d, 0x2e, 0x23, 0x16, 0x7b, 0x89, 0xba, 0xad, 0xd4,
+	0x1a, 0x51, 0x6a, 0x0f, 0x03, 0xd4, 0xea, 0x8e, 0x52, 0x6b, 0x64, 0x87, 0x93, 0x2d, 0x58, 0x1b,
+	0xb9, 0x7e, 0x80, 0x9e, 0x13, 0x44, 0x63, 0x3f, 0x74, 0x5c, 0xce, 0x71, 0x1a, 0x73, 0x66, 0x74,
+	0x65, 0xe8, 0x6f, 0x2b, 0xe5, 0x91, 0xd0, 0xed, 0x68, 0x95, 0x88, 0x54, 0x10, 0x0d, 0xcf, 0xd0,
+	0x73, 0x66, 0x21, 0xf7, 0x03, 0xa3, 0xa7, 0x22, 0xa5, 0xb0, 0x81, 0x80, 0xac, 0x17, 0xb0, 0xba,
+	0x37, 0xc1, 0xe1, 0xd9, 0xa1, 0x8f, 0x81, 0x27, 0x12, 0x6d, 0xe3, 0x3b, 0x72, 0x07, 0xaa, 0x23,
+	0x21, 0xeb, 0x74, 0x2b, 0x41, 0xa0, 0xe7, 0x6e, 0x30, 0x43, 0x99, 0xec, 0xa6, 0xad, 0x04, 0xeb,
+	0x33, 0x20, 0x37, 0x37, 0x60, 0xb1, 0x08, 0x32, 0xe3, 0x2e, 0x9f, 0x31, 0xb9, 0x45, 0xc3, 0xd6,
+	0x92, 0xf5, 0x39, 0xdc, 0x96, 0xd6, 0xfb, 0xf2, 0x5e, 0xff, 0x68, 0x3e, 0x00, 0x78, 0x89, 0xfc,
+	0x3f, 0x1c, 0x4b, 0x26, 0x8a, 0x39, 0xee, 0x90, 0xfb, 0xe7, 0xaa, 0xfc, 0x1a, 0x76, 0xc3, 0x67,
+	0x3b, 0x52, 0xb6, 0xde, 0xc2, 0xda, 0xde, 0xc4, 0x0d, 0xc7, 0xf2, 0x00, 0x6f, 0x74, 0x61, 0x08,
+	0x0f, 0x37, 0x4b, 0xab, 0xb4, 0xbc, 0xb4, 0xca, 0xd9, 0xd2, 0xb2, 0x9e, 0xc1, 0xdd, 0xbc, 0x7d,
+	0x97, 0x5c, 0x30, 0x80, 0x4e, 0x3a, 0x14, 0x1f, 0xee, 0x8e, 0x84, 0x40, 0x65, 0x1a, 0x79, 0x49,
+	0x73, 0xc9, 0x6f, 0xeb, 0xc7, 0x32, 0xb4, 0x8f, 0x7c, 0x26, 0x03, 0xca, 0x84, 0x37, 0x02, 0x95,
+	0xd8, 0x1d, 0xa3, 0x74, 0x56, 0xb1, 0xe5, 0xb7, 0xf0, 0x15, 0xf8, 0x53, 0x9f, 0xeb, 0x9e, 0x56,
+	0xc2, 0x72, 0x5f, 0xf3, 0xe3, 0x55, 0xd2, 0xc7, 0x9b, 0x5f, 0xa5, 0x9a, 0xbe, 0xca, 0x3a, 0x34,
+	0x24, 0x65, 0x38, 0xa7, 0x97, 0xba, 0x73, 0xeb, 0x52, 0xde, 0xbd, 0xd4, 0x3e, 0x54, 0x75, 0x1a,
+	0xf5, 0xc4, 0xc7, 0x91, 0x94, 0xc9, 0x26, 0xd4, 0x46, 0x7e, 0xc0, 0x75, 0xdb, 0xb6, 0xb6, 0x6e,
+	0x3d, 0x95, 0x5c, 0x25, 0xae, 0x72, 0x28, 0x71, 0x5b, 0xeb, 0x45, 0x23, 0x89, 0x8b, 0x64, 0xba,
+	0xb8, 0x29, 0x10, 0xd9, 0xc2, 0x56, 0x04, 0x9d, 0x54, 0x0c, 0x58, 0x4c, 0xfa, 0x50, 0x15, 0x5b,
+	0x89, 0xd4, 0xac, 0x6c, 0xb6, 0xb6, 0xe0, 0x7a, 0x63, 0x5b, 0x29, 0xc4, 0x4d, 0x86, 0xd1, 0x2c,
+	0x9c, 0x87, 0x44, 0x0a, 0xe4, 0x31, 0xf4, 0x42, 0x7c, 0xcf, 0x9d, 0x94, 0x33, 0xc5, 0x73, 0x1d,
+	0x01, 0xbf, 0x99, 0x3b, 0xac, 0x43, 0xf5, 0x60, 0x1a, 0xf3, 0x4b, 0x6b, 0x0a, 0xeb, 0x03, 0xd9,
+	0xee, 0x76, 0x8a, 0x52, 0x92, 0xc4, 0xdf, 0xe4, 0xd7, 0x05, 0x3a, 0x2a, 0xe7, 0xd3, 0x91, 0x87,
+	0x82, 0xb8, 0x1d, 0xdf, 0xd3, 0xce, 0x1b, 0x0a, 0x78, 0xe5, 0x59, 0xcf, 0xc1, 0x2c, 0x72, 0xb7,
+	0xa4, 0x22, 0x7f, 0x00, 0xe3, 0x2d, 0x52, 0x7f, 0x74, 0x29, 0x2c, 0xf7, 0x28, 0x7a, 0x18, 0x72,
+	0xdf, 0x0d, 0xd8, 0x07, 0x68, 0x8f, 0x9f, 0x4a, 0xb0, 0x5e, 0xb0, 0x37, 0x8b, 0x75, 0x11, 0xe9,
+	0x18, 0x34, 0x6c, 0x25, 0xe8, 0xb0, 0x94, 0xe7, 0x61, 0x21, 0x50, 0xa1, 0x51, 0x90, 0x4c, 0x14,
+	0xf9, 0x9d, 0xba, 0x8a, 0xaa, 0x3f, 0x2d, 0x2d, 0xd0, 0x5f, 0x75, 0x91, 0xfe, 0x1e, 0x42, 0x67,
+	0x10, 0x0a, 0xa0, 0x20, 0x0d, 0xd6, 0x26, 0x74, 0xd3, 0x06, 0x4b, 0x5b, 0x79, 0xdd, 0x8e, 0x78,
+	0x41, 0x76, 0x17, 0xb2, 0x59, 0xca, 0xc9, 0xe6, 0x13, 0x58, 0x0d, 0xf1, 0xc2, 0xc9, 0x4b, 0x7b,
+	0x2f, 0xc4, 0x8b, 0xf4, 0xbe, 0x16, 0x07, 0xb3, 0xc8, 0x5b, 0xf1, 0x19, 0xc9, 0x3d, 0xa8, 0xcb,
+	0xa1, 0x3d, 0x0f, 0x69, 0x4d, 0x88, 0xaf, 0x3c, 0xf2, 0x31, 0x74, 0x29, 0xce, 0x18, 0x3a, 0x1e,
+	0x72, 0x1c, 0x72, 0xf4, 0x74, 0x8f, 0x77, 0x24, 0xba, 0xaf, 0x41, 0xeb, 0x97, 0x32, 0xc0, 0x75,
+	0xc7, 0xa5, 0x46, 0x69, 0x29, 0x33, 0x4a, 0x1f, 0x43, 0xef, 0x7a, 0x80, 0x3b, 0x23, 0x1a, 0x4d,
+	0xb5, 0xbb, 0xce, 0x7c, 0x8a, 0x1f, 0xd2, 0x68, 0x4a, 0x2c, 0xe8, 0xa4, 0xec, 0x78, 0xa4, 0xb3,
+	0xda, 0x9a, 0x5b, 0x9d, 0x44, 0x82, 0x2f, 0xdc, 0xb1, 0xde, 0x44, 0xa4, 0xb7, 0x63, 0xd7, 0xdd,
+	0xb1, 0x5a, 0xbe, 0x06, 0x35, 0xd5, 0x7a, 0x32, 0xb3, 0x1d, 0xbb, 0x2a, 0x5b, 0x4e, 0xa4, 0x3d,
+	0x19, 0xc3, 0x72, 0x95, 0x7e, 0x1f, 0x68, 0x4c, 0xae, 0x4c, 0x4d, 0x6a, 0x1e, 0x19, 0xf5, 0xcc,
+	0xa4, 0x3e, 0x89, 0x44, 0xb6, 0x92, 0x51, 0x2c, 0x02, 0x87, 0xfa, 0xa5, 0xd0, 0xd6, 0xe0, 0xb1,
+	0xc0, 0x44, 0x25, 0xca, 0xd7, 0x8b, 0x22, 0x18, 0xf9, 0x6d, 0x7d, 0x03, 0xdd, 0x63, 0x74, 0xe9,
+	0x70, 0x32, 0x67, 0xd8, 0x3b, 0x50, 0x7d, 0x37, 0x43, 0x7a, 0x99, 0xf0, 0xb9, 0x14, 0xf2, 0x39,
+	0xd6, 0xda, 0x86, 0x5e, 0x66, 0xf5, 0xbf, 0xe1, 0x26, 0xab, 0x0f, 0x5d, 0x1b, 0x19, 0x8f, 0x28,
+	0x16, 0x95, 0xf0, 0xa7, 0xd0, 0xcb, 0x58, 0x2c, 0xa9, 0xe1, 0x23, 0x58, 0x3d, 0x78, 0x1f, 0x47,
+	0x54, 0xb2, 0xe3, 0xbe, 0xcb, 0xdd, 0x3c, 0x66, 0xfa, 0x04, 0x7a, 0x7e, 0x38, 0x0c, 0x66, 0x1e,
+	0x3a, 0xa7, 0x51, 0x74, 0xe6, 0x87, 0x63, 0x79, 0x8d, 0x86, 0xdd, 0xd5, 0xf0, 0xae, 0x42, 0xad,
+	0x27, 0x40, 0x6e, 0xee, 0xa6, 0xfa, 0x7c, 0x38, 0x99, 0x85, 0x67, 0x72, 0xc7, 0xb6, 0xad, 0x84,
+	0xad, 0xbf, 0x6a, 0xd0, 0x12, 0x66, 0xc7, 0xea, 0x21, 0x4a, 0xfa, 0x50, 0xdb, 0x93, 0xf9, 0x20,
+	0xa9, 0x3b, 0x9b, 0xa9, 0x6f, 0x61, 0xa1, 0xe8, 0xad, 0xd0, 0xe2, 0x11, 0xac, 0xbc, 0x44, 0x4e,
+	0xf4, 0xa4, 0xb8, 0x7e, 0x48, 0x64, 0x8c, 0x9e, 0x43, 0x73, 0x3e, 0x0e, 0x08, 0x51, 0x8a, 0xf4,
+	0x8c, 0x34, 0x6f, 0x2f, 0x60, 0x2c, 0x26, 0x5f, 0x41, 0x4d, 0xcd, 0x6d, 0xa2, 0xd5, 0x99, 0x29,
+	0x6e, 0xae, 0x2b, 0x30, 0xef, 0xa9, 0xf3, 0x02, 0xe0, 0xfa, 0xbd, 0x44, 0xee, 0xa5, 0x0c, 0xd3,
+	0x4f, 0x30, 0xd3, 0xc8, 0x57, 0xb0, 0x98, 0x7c, 0x0b, 0x5d, 0xf5, 0xc8, 0x48, 0x1e, 0x18, 0xe4,
+	0x7e, 0x62, 0x9b, 0xf3, 0xa4, 0x31, 0xff, 0x5f, 0xac, 0x64, 0x31, 0xf9, 0x1e, 0xc8, 0xe2, 0x8c,
+	0x20, 0x0f, 0x75, 0x7c, 0x8a, 0x86, 0x95, 0xd9, 0x5f, 0x6e, 0xc0, 0x62, 0x72, 0x02, 0xab, 0x8a,
+	0xea, 0x53, 0x34, 0x4f, 0x36, 0xd4, 0xb2, 0xa2, 0xf9, 0x62, 0x3e, 0x5c, 0xaa, 0x67, 0x31, 0xd9,
+	0x86, 0x9a, 0x62, 0xe3, 0x24, 0xec, 0x19, 0xf2, 0x36, 0xef, 0x2c, 0x82, 0xea, 0x8e, 0x8b, 0x54,
+	0x99, 0xdc, 0xb1, 0x90, 0xb2, 0xcd, 0xfe, 0x72, 0x03, 0x16, 0x93, 0xaf, 0xa1, 0x95, 0xea, 0x57,
+	0xa2, 0xbd, 0x67, 0x09, 0xc0, 0x5c, 0xcb, 0x41, 0x59, 0x4c, 0xbe, 0x84, 0xba, 0x6e, 0xca, 0x64,
+	0x5d, 0xb6, 0x8b, 0xcd, 0xb5, 0x1c, 0x94, 0xc5, 0xe4, 0x00, 0xba, 0xd9, 0x9e, 0x4a, 0x4a, 0x68,
+	0xa1, 0x6f, 0x4d, 0x23, 0x5f, 0xc1, 0xe2, 0x67, 0xa5, 0xdd, 0x5b, 0xbf, 0x5e, 0x6d, 0x94, 0x7e,
+	0xbb, 0xda, 0x28, 0xfd, 0x71, 0xb5, 0x51, 0xfa, 0xf9, 0xcf, 0x8d, 0xff, 0x9d, 0xd6, 0xe4, 0x5f,
+	0xdf, 0xf6, 0xdf, 0x03, 0x00, 0xa7, 0x7b, 0x3a, 0xd3, 0x10, 0x0e, 0x00, 0x00,
 }
 
-func (m *ChangeUserPasswordResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserServiceClient interface {
+	Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
+	Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
+	Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error)
+	Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*CheckDeleteUserResp, error)
+	CheckField(ctx context.Context, in *CheckFieldUserReq, opts ...grpc.CallOption) (*CheckFieldUserResp, error)
+	ChangePassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*ChangeUserPasswordResp, error)
+	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenUserReq, opts ...grpc.CallOption) (*UpdateRefreshTokenUserResp, error)
+	VerifyCredentials(ctx context.Context, in *VerifyUserCredentialsReq, opts ...grpc.CallOption) (*VerifyUserCredentialsResp, error)
+	Unlock(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error)
+	RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenUserReq, opts ...grpc.CallOption) (*RotateRefreshTokenUserResp, error)
+	SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersResp, error)
+	Restore(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (UserService_ExportUserDataClient, error)
+}
+
+type userServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUserServiceClient(cc *grpc.ClientConn) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/user.UserService/Create", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ChangeUserPasswordResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/user.UserService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ChangeUserPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *userServiceClient) Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/user.UserService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DeleteUserReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error) {
+	out := new(ListUsersResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/ListUsers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteUserReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *DeleteUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*CheckDeleteUserResp, error) {
+	out := new(CheckDeleteUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListUsersReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) CheckField(ctx context.Context, in *CheckFieldUserReq, opts ...grpc.CallOption) (*CheckFieldUserResp, error) {
+	out := new(CheckFieldUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/CheckField", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListUsersReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *ListUsersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IsActive {
-		i--
-		if m.IsActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Limit != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Page != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Page))
-		i--
-		dAtA[i] = 0x8
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangeUserPasswordReq, opts ...grpc.CallOption) (*ChangeUserPasswordResp, error) {
+	out := new(ChangeUserPasswordResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListUsersResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenUserReq, opts ...grpc.CallOption) (*UpdateRefreshTokenUserResp, error) {
+	out := new(UpdateRefreshTokenUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/UpdateRefreshToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListUsersResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) VerifyCredentials(ctx context.Context, in *VerifyUserCredentialsReq, opts ...grpc.CallOption) (*VerifyUserCredentialsResp, error) {
+	out := new(VerifyUserCredentialsResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/VerifyCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListUsersResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintUser(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintUser(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *userServiceClient) Unlock(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error) {
+	out := new(UnlockUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Empty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenUserReq, opts ...grpc.CallOption) (*RotateRefreshTokenUserResp, error) {
+	out := new(RotateRefreshTokenUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/RotateRefreshToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersResp, error) {
+	out := new(SearchUsersResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *userServiceClient) Restore(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error) {
+	out := new(RestoreUserResp)
+	err := c.cc.Invoke(ctx, "/user.UserService/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateRefreshTokenUserReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (UserService_ExportUserDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[0], "/user.UserService/ExportUserData", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &userServiceExportUserDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *UpdateRefreshTokenUserReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UserService_ExportUserDataClient interface {
+	Recv() (*ExportUserDataResp, error)
+	grpc.ClientStream
 }
 
-func (m *UpdateRefreshTokenUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+type userServiceExportUserDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportUserDataClient) Recv() (*ExportUserDataResp, error) {
+	m := new(ExportUserDataResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *UpdateRefreshTokenUserResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UserServiceServer is the server API for UserService service.
+type UserServiceServer interface {
+	Create(context.Context, *User) (*User, error)
+	Update(context.Context, *User) (*User, error)
+	Get(context.Context, *GetUserReq) (*User, error)
+	ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error)
+	Delete(context.Context, *DeleteUserReq) (*CheckDeleteUserResp, error)
+	CheckField(context.Context, *CheckFieldUserReq) (*CheckFieldUserResp, error)
+	ChangePassword(context.Context, *ChangeUserPasswordReq) (*ChangeUserPasswordResp, error)
+	UpdateRefreshToken(context.Context, *UpdateRefreshTokenUserReq) (*UpdateRefreshTokenUserResp, error)
+	VerifyCredentials(context.Context, *VerifyUserCredentialsReq) (*VerifyUserCredentialsResp, error)
+	Unlock(context.Context, *UnlockUserReq) (*UnlockUserResp, error)
+	RotateRefreshToken(context.Context, *RotateRefreshTokenUserReq) (*RotateRefreshTokenUserResp, error)
+	SearchUsers(context.Context, *SearchUsersReq) (*SearchUsersResp, error)
+	Restore(context.Context, *RestoreUserReq) (*RestoreUserResp, error)
+	ExportUserData(*ExportUserDataReq, UserService_ExportUserDataServer) error
 }
 
-func (m *UpdateRefreshTokenUserResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
 }
 
-func (m *UpdateRefreshTokenUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedUserServiceServer) Create(ctx context.Context, req *User) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedUserServiceServer) Update(ctx context.Context, req *User) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedUserServiceServer) Get(ctx context.Context, req *GetUserReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedUserServiceServer) ListUsers(ctx context.Context, req *ListUsersReq) (*ListUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) Delete(ctx context.Context, req *DeleteUserReq) (*CheckDeleteUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedUserServiceServer) CheckField(ctx context.Context, req *CheckFieldUserReq) (*CheckFieldUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+}
+func (*UnimplementedUserServiceServer) ChangePassword(ctx context.Context, req *ChangeUserPasswordReq) (*ChangeUserPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedUserServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenUserReq) (*UpdateRefreshTokenUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+}
+func (*UnimplementedUserServiceServer) VerifyCredentials(ctx context.Context, req *VerifyUserCredentialsReq) (*VerifyUserCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
+}
+func (*UnimplementedUserServiceServer) Unlock(ctx context.Context, req *UnlockUserReq) (*UnlockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (*UnimplementedUserServiceServer) RotateRefreshToken(ctx context.Context, req *RotateRefreshTokenUserReq) (*RotateRefreshTokenUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRefreshToken not implemented")
+}
+func (*UnimplementedUserServiceServer) SearchUsers(ctx context.Context, req *SearchUsersReq) (*SearchUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (*UnimplementedUserServiceServer) Restore(ctx context.Context, req *RestoreUserReq) (*RestoreUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedUserServiceServer) ExportUserData(req *ExportUserDataReq, srv UserService_ExportUserDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
 }
 
-func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUser(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
+	s.RegisterService(&_UserService_serviceDesc, srv)
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.UserOrder != 0 {
-		n += 1 + sovUser(uint64(m.UserOrder))
-	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+
+func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).Create(ctx, in)
 	}
-	l = len(m.BirthDate)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Create",
 	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Create(ctx, req.(*User))
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).Update(ctx, in)
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Update",
 	}
-	l = len(m.ImageUrl)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Update(ctx, req.(*User))
 	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).Get(ctx, in)
 	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Get",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Get(ctx, req.(*GetUserReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckFieldUserReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/ListUsers",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckFieldUserResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if interceptor == nil {
+		return srv.(UserServiceServer).Delete(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Delete",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Delete(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckDeleteUserResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFieldUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckField(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/CheckField",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckField(ctx, req.(*CheckFieldUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
 	}
-	if m.IsActive {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/ChangePassword",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangeUserPasswordReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChangeUserPasswordReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRefreshTokenUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateRefreshToken(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UpdateRefreshToken",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenUserReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChangeUserPasswordResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_VerifyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyUserCredentialsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyCredentials(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/VerifyCredentials",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyCredentials(ctx, req.(*VerifyUserCredentialsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteUserReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+func _UserService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).Unlock(ctx, in)
 	}
-	if m.IsActive {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Unlock",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Unlock(ctx, req.(*UnlockUserReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListUsersReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRefreshTokenUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Page != 0 {
-		n += 1 + sovUser(uint64(m.Page))
+	if interceptor == nil {
+		return srv.(UserServiceServer).RotateRefreshToken(ctx, in)
 	}
-	if m.Limit != 0 {
-		n += 1 + sovUser(uint64(m.Limit))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/RotateRefreshToken",
 	}
-	if m.IsActive {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RotateRefreshToken(ctx, req.(*RotateRefreshTokenUserReq))
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
 	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/SearchUsers",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListUsersResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _UserService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovUser(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Restore(ctx, in)
 	}
-	if m.Count != 0 {
-		n += 1 + sovUser(uint64(m.Count))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/Restore",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Restore(ctx, req.(*RestoreUserReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _UserService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(UserServiceServer).ExportUserData(m, &userServiceExportUserDataServer{stream})
 }
 
-func (m *UpdateRefreshTokenUserReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovUser(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type UserService_ExportUserDataServer interface {
+	Send(*ExportUserDataResp) error
+	grpc.ServerStream
 }
 
-func (m *UpdateRefreshTokenUserResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+type userServiceExportUserDataServer struct {
+	grpc.ServerStream
 }
 
-func sovUser(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (x *userServiceExportUserDataServer) Send(m *ExportUserDataResp) error {
+	return x.ServerStream.SendMsg(m)
 }
-func sozUser(x uint64) (n int) {
-	return sovUser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+var _UserService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "user.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _UserService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _UserService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _UserService_Get_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserService_ListUsers_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _UserService_Delete_Handler,
+		},
+		{
+			MethodName: "CheckField",
+			Handler:    _UserService_CheckField_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "UpdateRefreshToken",
+			Handler:    _UserService_UpdateRefreshToken_Handler,
+		},
+		{
+			MethodName: "VerifyCredentials",
+			Handler:    _UserService_VerifyCredentials_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _UserService_Unlock_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _UserService_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _UserService_Restore_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _UserService_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user_service/user.proto",
 }
-func (m *User) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
+
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LockedUntil) > 0 {
+		i -= len(m.LockedUntil)
+		copy(dAtA[i:], m.LockedUntil)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LockedUntil)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.FailedLoginAttempts != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.FailedLoginAttempts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ImageUrl) > 0 {
+		i -= len(m.ImageUrl)
+		copy(dAtA[i:], m.ImageUrl)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ImageUrl)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BirthDate) > 0 {
+		i -= len(m.BirthDate)
+		copy(dAtA[i:], m.BirthDate)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.BirthDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UserOrder != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.UserOrder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckFieldUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckFieldUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckFieldUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckFieldUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckFieldUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckFieldUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckDeleteUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckDeleteUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckDeleteUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeUserPasswordReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeUserPasswordReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeUserPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeUserPasswordResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeUserPasswordResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeUserPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListUsersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUsersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListUsersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUser(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.IsLocked {
+		i--
+		if m.IsLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsActive {
+		i--
+		if m.IsActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListUsersResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUsersResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListUsersResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeviceId) > 0 {
+		i -= len(m.DeviceId)
+		copy(dAtA[i:], m.DeviceId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.DeviceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyUserCredentialsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyUserCredentialsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyUserCredentialsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyUserCredentialsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyUserCredentialsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyUserCredentialsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LockedUntil) > 0 {
+		i -= len(m.LockedUntil)
+		copy(dAtA[i:], m.LockedUntil)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.LockedUntil)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateRefreshTokenUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateRefreshTokenUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateRefreshTokenUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewRefreshToken) > 0 {
+		i -= len(m.NewRefreshToken)
+		copy(dAtA[i:], m.NewRefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.NewRefreshToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateRefreshTokenUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateRefreshTokenUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateRefreshTokenUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReuseDetected {
+		i--
+		if m.ReuseDetected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DeletedState) > 0 {
+		i -= len(m.DeletedState)
+		copy(dAtA[i:], m.DeletedState)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.DeletedState)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreatedTo) > 0 {
+		i -= len(m.CreatedTo)
+		copy(dAtA[i:], m.CreatedTo)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedTo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreatedFrom) > 0 {
+		i -= len(m.CreatedFrom)
+		copy(dAtA[i:], m.CreatedFrom)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.CreatedFrom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AgeTo != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.AgeTo))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AgeFrom != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.AgeFrom))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BirthDateTo) > 0 {
+		i -= len(m.BirthDateTo)
+		copy(dAtA[i:], m.BirthDateTo)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.BirthDateTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BirthDateFrom) > 0 {
+		i -= len(m.BirthDateFrom)
+		copy(dAtA[i:], m.BirthDateFrom)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.BirthDateFrom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchUsersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchUsersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchUsersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchUsersResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchUsersResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchUsersResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreUserResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreUserResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreUserResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportUserDataReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportUserDataReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportUserDataReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeBooking {
+		i--
+		if m.IncludeBooking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportUserDataResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportUserDataResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportUserDataResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.UserOrder != 0 {
+		n += 1 + sovUser(uint64(m.UserOrder))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.BirthDate)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.ImageUrl)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.FailedLoginAttempts != 0 {
+		n += 1 + sovUser(uint64(m.FailedLoginAttempts))
+	}
+	l = len(m.LockedUntil)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckFieldUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckFieldUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckDeleteUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeUserPasswordReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeUserPasswordResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListUsersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovUser(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.IsActive {
+		n += 2
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.IsLocked {
+		n += 2
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListUsersResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovUser(uint64(m.Count))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.DeviceId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyUserCredentialsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyUserCredentialsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.LockedUntil)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnlockUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UnlockUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRefreshTokenUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.NewRefreshToken)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RotateRefreshTokenUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.ReuseDetected {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.BirthDateFrom)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.BirthDateTo)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.AgeFrom != 0 {
+		n += 1 + sovUser(uint64(m.AgeFrom))
+	}
+	if m.AgeTo != 0 {
+		n += 1 + sovUser(uint64(m.AgeTo))
+	}
+	l = len(m.CreatedFrom)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.CreatedTo)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.DeletedState)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchUsersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovUser(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchUsersResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreUserResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportUserDataReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.IncludeBooking {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportUserDataResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovUser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUser(x uint64) (n int) {
+	return sovUser(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOrder", wireType)
+			}
+			m.UserOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserOrder |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedLoginAttempts", wireType)
+			}
+			m.FailedLoginAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedLoginAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckFieldUserReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckFieldUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckFieldUserResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckFieldUserResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckFieldUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckDeleteUserResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckDeleteUserResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckDeleteUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeUserPasswordReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeUserPasswordReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeUserPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeUserPasswordResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeUserPasswordResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeUserPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteUserReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsersReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2229,13 +5665,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLocked", wireType)
 			}
-			m.UserOrder = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -2245,16 +5681,17 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UserOrder |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IsLocked = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -2264,27 +5701,31 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &UserFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2312,13 +5753,64 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsersResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsersResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -2328,27 +5820,48 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2376,11 +5889,113 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRefreshTokenUserReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRefreshTokenUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2408,11 +6023,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2440,11 +6055,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gender = string(dAtA[iNdEx:postIndex])
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2472,45 +6087,64 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.DeviceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRefreshTokenUserResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRefreshTokenUserResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRefreshTokenUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -2520,27 +6154,66 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyUserCredentialsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyUserCredentialsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyUserCredentialsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2568,11 +6241,11 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2600,7 +6273,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2624,7 +6297,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
+func (m *VerifyUserCredentialsResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2643,19 +6316,103 @@ func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CheckFieldUserReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckFieldUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyUserCredentialsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyUserCredentialsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2683,11 +6440,11 @@ func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedUntil", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2715,7 +6472,7 @@ func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.LockedUntil = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2739,7 +6496,7 @@ func (m *CheckFieldUserReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckFieldUserResp) Unmarshal(dAtA []byte) error {
+func (m *UnlockUserReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2762,17 +6519,17 @@ func (m *CheckFieldUserResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckFieldUserResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockUserReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckFieldUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -2782,12 +6539,24 @@ func (m *CheckFieldUserResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -2810,7 +6579,7 @@ func (m *CheckFieldUserResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckDeleteUserResp) Unmarshal(dAtA []byte) error {
+func (m *UnlockUserResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2833,10 +6602,10 @@ func (m *CheckDeleteUserResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckDeleteUserResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnlockUserResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckDeleteUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnlockUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2881,7 +6650,7 @@ func (m *CheckDeleteUserResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserReq) Unmarshal(dAtA []byte) error {
+func (m *RotateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,15 +6673,15 @@ func (m *GetUserReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateRefreshTokenUserReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateRefreshTokenUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2940,11 +6709,11 @@ func (m *GetUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRefreshToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2972,28 +6741,8 @@ func (m *GetUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.NewRefreshToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3016,7 +6765,7 @@ func (m *GetUserReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeUserPasswordReq) Unmarshal(dAtA []byte) error {
+func (m *RotateRefreshTokenUserResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3039,17 +6788,17 @@ func (m *ChangeUserPasswordReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeUserPasswordReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RotateRefreshTokenUserResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeUserPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RotateRefreshTokenUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3059,27 +6808,15 @@ func (m *ChangeUserPasswordReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Status = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3107,62 +6844,11 @@ func (m *ChangeUserPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.UserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChangeUserPasswordResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeUserPasswordResp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeUserPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReuseDetected", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3179,7 +6865,7 @@ func (m *ChangeUserPasswordResp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			m.ReuseDetected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3202,7 +6888,7 @@ func (m *ChangeUserPasswordResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
+func (m *UserFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3225,15 +6911,15 @@ func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteUserReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3261,11 +6947,11 @@ func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Gender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDateFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3293,13 +6979,13 @@ func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BirthDateFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDateTo", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3309,68 +6995,48 @@ func (m *DeleteUserReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BirthDateTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeFrom", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AgeFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgeFrom |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListUsersReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUsersReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgeTo", wireType)
 			}
-			m.Page = 0
+			m.AgeTo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3380,16 +7046,16 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
+				m.AgeTo |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedFrom", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3399,16 +7065,29 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTo", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3418,15 +7097,27 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsActive = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3454,11 +7145,11 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.DeletedState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3486,11 +7177,62 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchUsersReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchUsersReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchUsersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3518,8 +7260,27 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3542,7 +7303,7 @@ func (m *ListUsersReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
+func (m *SearchUsersResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3565,10 +7326,10 @@ func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUsersResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchUsersResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUsersResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchUsersResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3605,11 +7366,62 @@ func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Count = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreUserReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreUserReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3619,11 +7431,24 @@ func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3646,7 +7471,7 @@ func (m *ListUsersResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Empty) Unmarshal(dAtA []byte) error {
+func (m *RestoreUserResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3669,12 +7494,32 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreUserResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3697,7 +7542,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
+func (m *ExportUserDataReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3720,10 +7565,10 @@ func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenUserReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportUserDataReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportUserDataReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3759,10 +7604,10 @@ func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBooking", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3772,24 +7617,12 @@ func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IncludeBooking = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -3812,7 +7645,7 @@ func (m *UpdateRefreshTokenUserReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenUserResp) Unmarshal(dAtA []byte) error {
+func (m *ExportUserDataResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3835,17 +7668,17 @@ func (m *UpdateRefreshTokenUserResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenUserResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportUserDataResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenUserResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportUserDataResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -3855,12 +7688,26 @@ func (m *UpdateRefreshTokenUserResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
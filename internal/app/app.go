@@ -1,13 +1,25 @@
 package app
 
 import (
+	"context"
 	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/delivery/grpc/kafka/handlers"
 	grpc_server "dennic_user_service/internal/delivery/grpc/server"
 	invest_grpc "dennic_user_service/internal/delivery/grpc/services"
+	"dennic_user_service/internal/delivery/worker"
+	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/grpc_service_clients"
 	"dennic_user_service/internal/infrastructure/kafka"
+	"dennic_user_service/internal/infrastructure/memory_broker"
+	"dennic_user_service/internal/infrastructure/minio"
 	adminRepo "dennic_user_service/internal/infrastructure/repository/postgresql/admin"
+	loginAttemptRepo "dennic_user_service/internal/infrastructure/repository/postgresql/login_attempt"
+	outboxRepo "dennic_user_service/internal/infrastructure/repository/postgresql/outbox"
+	processedMessageRepo "dennic_user_service/internal/infrastructure/repository/postgresql/processed_message"
+	purgeRepo "dennic_user_service/internal/infrastructure/repository/postgresql/purge"
+	refreshTokenRepo "dennic_user_service/internal/infrastructure/repository/postgresql/refresh_token"
 	userRepo "dennic_user_service/internal/infrastructure/repository/postgresql/user"
+	"dennic_user_service/internal/pkg/backoff"
 	"dennic_user_service/internal/pkg/config"
 	"dennic_user_service/internal/pkg/logger"
 	"dennic_user_service/internal/pkg/otlp"
@@ -15,6 +27,8 @@ import (
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -25,6 +39,16 @@ import (
 	"google.golang.org/grpc"
 )
 
+const (
+	// modes select the components an instance runs, every mode runs the background workers
+	ModeGrpc     = "grpc"
+	ModeConsumer = "consumer"
+	ModeAll      = "all"
+
+	BrokerKafka  = "kafka"
+	BrokerMemory = "memory"
+)
+
 type App struct {
 	Config         *config.Config
 	Logger         *zap.Logger
@@ -34,64 +58,118 @@ type App struct {
 	ServiceClients grpc_service_clients.ServiceClients
 	BrokerProducer event.BrokerProducer
 	BrokerConsumer event.BrokerConsumer
+
+	shutdownTimeout time.Duration
+	// background workers finish their current run once stopWorkers is closed, abortWorkers
+	// cancels that run when the shutdown timeout passes
+	workers      sync.WaitGroup
+	stopWorkers  chan struct{}
+	abortWorkers context.CancelFunc
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
+	if cfg.Mode != ModeGrpc && cfg.Mode != ModeConsumer && cfg.Mode != ModeAll {
+		return nil, fmt.Errorf("unknown mode %q, expected %s, %s or %s", cfg.Mode, ModeGrpc, ModeConsumer, ModeAll)
+	}
+
+	// only the gRPC server verifies caller tokens
+	if cfg.Mode != ModeConsumer && cfg.Token.SigningKey == "" {
+		return nil, fmt.Errorf("token signing key is not set, set TOKEN_SIGNING_KEY")
+	}
+
 	// init logger
 	logger, err := logger.New(cfg.LogLevel, cfg.Environment, cfg.APP+".log")
 	if err != nil {
 		return nil, err
 	}
 
-	kafkaProducer := kafka.NewProducer(cfg, logger)
-	kafkaConsumer := kafka.NewConsumer(logger)
+	shutdownTimeout, err := time.ParseDuration(cfg.Context.ShutdownTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error during parse duration for shutdown timeout : %w", err)
+	}
+
+	app := &App{
+		Config:          cfg,
+		Logger:          logger,
+		shutdownTimeout: shutdownTimeout,
+	}
+
+	// broker initialization
+	if err := app.initBroker(); err != nil {
+		return nil, err
+	}
 
 	// otlp collector initialization
-	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
-	if err != nil {
+	if app.ShutdownOTLP, err = otlp.InitOTLPProvider(cfg); err != nil {
 		return nil, err
 	}
 
 	// init db
-	db, err := postgres.New(cfg)
-	if err != nil {
+	if app.DB, err = postgres.New(cfg); err != nil {
 		return nil, err
 	}
-	consumerApp, err := NewUserCreateConsumerCLI(cfg, logger, db, kafkaConsumer)
-	if err != nil {
-		return nil, err
+
+	if !app.runsGrpc() {
+		return app, nil
 	}
 	// grpc server init
-	grpcServer := grpc.NewServer(
+	app.GrpcServer = grpc.NewServer(
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_ctxtags.StreamServerInterceptor(),
 			grpc_zap.StreamServerInterceptor(logger),
+			grpc_server.StreamErrorInterceptor(cfg.Environment),
 			grpc_recovery.StreamServerInterceptor(),
+			grpc_server.StreamInterceptorData(logger, cfg.Token.SigningKey),
 		)),
 		grpc.UnaryInterceptor(grpc_server.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				grpc_ctxtags.UnaryServerInterceptor(),
 				grpc_zap.UnaryServerInterceptor(logger),
+				grpc_server.UnaryErrorInterceptor(cfg.Environment),
 				grpc_recovery.UnaryServerInterceptor(),
 			),
-			grpc_server.UnaryInterceptorData(logger),
+			grpc_server.UnaryInterceptorData(logger, cfg.Token.SigningKey),
 		)),
 	)
 
-	return &App{
-		Config:         cfg,
-		Logger:         logger,
-		DB:             db,
-		GrpcServer:     grpcServer,
-		ShutdownOTLP:   shutdownOTLP,
-		BrokerProducer: kafkaProducer,
-		BrokerConsumer: consumerApp.BrokerConsumer,
-	}, nil
+	return app, nil
+}
+
+// initBroker creates the producer and, when the mode runs consumers, the consumer of the broker
+func (a *App) initBroker() error {
+	var err error
+	switch a.Config.Broker {
+	case BrokerKafka:
+		a.BrokerProducer = kafka.NewProducer(a.Config, a.Logger)
+		if a.runsConsumer() {
+			a.BrokerConsumer, err = kafka.NewConsumer(a.Config, a.Logger)
+		}
+	case BrokerMemory:
+		broker := memory_broker.NewBroker()
+		a.BrokerProducer = memory_broker.NewProducer(broker)
+		if a.runsConsumer() {
+			a.BrokerConsumer, err = memory_broker.NewConsumer(broker, a.Config, a.Logger)
+		}
+	default:
+		return fmt.Errorf("unknown broker %q, expected %s or %s", a.Config.Broker, BrokerKafka, BrokerMemory)
+	}
+	return err
+}
+
+func (a *App) runsGrpc() bool {
+	return a.Config.Mode == ModeGrpc || a.Config.Mode == ModeAll
+}
+
+func (a *App) runsConsumer() bool {
+	return a.Config.Mode == ModeConsumer || a.Config.Mode == ModeAll
 }
 
-func (a *App) Run() error {
+// Run starts the components of the mode and serves until ctx is done or the gRPC server fails,
+// Stop shuts the app down afterwards
+func (a *App) Run(ctx context.Context) error {
 	var (
-		contextTimeout time.Duration
+		contextTimeout  time.Duration
+		refreshTokenTTL time.Duration
 	)
 
 	// context timeout initialization
@@ -99,6 +177,51 @@ func (a *App) Run() error {
 	if err != nil {
 		return fmt.Errorf("error during parse duration for context timeout : %w", err)
 	}
+
+	// refresh token ttl initialization
+	refreshTokenTTL, err = time.ParseDuration(a.Config.Token.RefreshTTL)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for refresh token ttl : %w", err)
+	}
+	// purge worker initialization
+	purgeRetention, err := time.ParseDuration(a.Config.Purge.Retention)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for purge retention : %w", err)
+	}
+	purgeInterval, err := time.ParseDuration(a.Config.Purge.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for purge interval : %w", err)
+	}
+	purgeBatchSize, err := strconv.ParseUint(a.Config.Purge.BatchSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse purge batch size : %w", err)
+	}
+	if purgeBatchSize == 0 {
+		return fmt.Errorf("purge batch size must be positive")
+	}
+	if a.Config.Purge.Mode != entity.PurgeModeDelete && a.Config.Purge.Mode != entity.PurgeModeAnonymize {
+		return fmt.Errorf("unknown purge mode %q, expected %s or %s", a.Config.Purge.Mode, entity.PurgeModeDelete, entity.PurgeModeAnonymize)
+	}
+	// outbox relay initialization
+	outboxInterval, err := time.ParseDuration(a.Config.Outbox.Interval)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox interval : %w", err)
+	}
+	outboxBatchSize, err := strconv.ParseUint(a.Config.Outbox.BatchSize, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error during parse outbox batch size : %w", err)
+	}
+	if outboxBatchSize == 0 {
+		return fmt.Errorf("outbox batch size must be positive")
+	}
+	outboxBackoffBase, err := time.ParseDuration(a.Config.Outbox.BackoffBase)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox backoff base : %w", err)
+	}
+	outboxBackoffMax, err := time.ParseDuration(a.Config.Outbox.BackoffMax)
+	if err != nil {
+		return fmt.Errorf("error during parse duration for outbox backoff max : %w", err)
+	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -106,31 +229,105 @@ func (a *App) Run() error {
 	}
 	a.ServiceClients = serviceClients
 
+	// image storage initialization
+	imageStorage, err := minio.NewImageStorage(a.Config)
+	if err != nil {
+		return fmt.Errorf("error during initialize image storage: %w", err)
+	}
+
 	// repositories initialization
 	userRepo := userRepo.NewUserRepo(a.DB)
 	adminRepo := adminRepo.NewAdminRepo(a.DB)
+	loginAttemptRepo := loginAttemptRepo.NewLoginAttemptRepo(a.DB)
+	refreshTokenRepo := refreshTokenRepo.NewRefreshTokenRepo(a.DB)
+	purgeRepo := purgeRepo.NewPurgeRepo(a.DB)
+	processedMessageRepo := processedMessageRepo.NewProcessedMessageRepo(a.DB)
+	outboxRepo := outboxRepo.NewOutboxRepo(a.DB)
 
 	// usecase initialization
-	userUsecase := usecase.NewUserService(contextTimeout, userRepo)
-	adminUsecase := usecase.NewAdminService(contextTimeout, adminRepo)
+	userUsecase := usecase.NewUserService(contextTimeout, userRepo, loginAttemptRepo, refreshTokenRepo, refreshTokenTTL, outboxRepo, processedMessageRepo, imageStorage, a.DB)
+	adminUsecase := usecase.NewAdminService(contextTimeout, adminRepo, loginAttemptRepo, refreshTokenRepo, refreshTokenTTL, outboxRepo, a.DB)
+	purgeUsecase := usecase.NewPurgeService(contextTimeout, purgeRepo, outboxRepo, imageStorage, a.DB, purgeRetention, purgeBatchSize, a.Config.Purge.Mode)
+	outboxUsecase := usecase.NewOutboxService(contextTimeout, outboxRepo, a.DB, a.BrokerProducer, backoff.Exponential{
+		Base:   outboxBackoffBase,
+		Max:    outboxBackoffMax,
+		Jitter: 0.2,
+	}, outboxBatchSize)
+
+	// background workers stop together with the app
+	workersCtx, abortWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = make(chan struct{})
+	a.abortWorkers = abortWorkers
+	a.runWorker(func() {
+		worker.NewPurgeWorker(a.Logger, purgeUsecase, purgeInterval).Run(workersCtx, a.stopWorkers)
+	})
+	a.runWorker(func() {
+		worker.NewOutboxWorker(a.Logger, outboxUsecase, outboxInterval).Run(workersCtx, a.stopWorkers)
+	})
+
+	// consumers initialization
+	if a.runsConsumer() {
+		if err := handlers.NewUserCreateHandler(a.Config, a.BrokerConsumer, a.Logger, userUsecase).HandlerEvents(); err != nil {
+			return fmt.Errorf("error during register user create consumer: %w", err)
+		}
+		a.Logger.Info("Kafka consumers running", zap.Strings("brokers", a.Config.Kafka.Address))
+		a.BrokerConsumer.Run()
+	}
+
+	served := make(chan error, 1)
+	if a.runsGrpc() {
+		pb.RegisterUserServiceServer(a.GrpcServer, invest_grpc.NewUserRPC(a.Logger, userUsecase, a.ServiceClients))
+		pb.RegisterAdminServiceServer(a.GrpcServer, invest_grpc.NewAdminRPC(a.Logger, adminUsecase))
+		a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
+		go func() {
+			served <- grpc_server.Run(a.Config, a.GrpcServer)
+		}()
+	}
 
-	pb.RegisterUserServiceServer(a.GrpcServer, invest_grpc.NewUserRPC(a.Logger, userUsecase, a.BrokerProducer))
-	pb.RegisterAdminServiceServer(a.GrpcServer, invest_grpc.NewAdminRPC(a.Logger, adminUsecase, a.BrokerProducer))
-	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
-	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
-		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-served:
+		if err != nil {
+			return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
+		}
+		return nil
 	}
+}
 
-	return nil
+func (a *App) runWorker(run func()) {
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		run()
+	}()
 }
 
+// Stop shuts the app down within the shutdown timeout. Incoming work stops first: the gRPC server
+// finishes in-flight RPCs and the consumer its current messages. The workers then finish their run
+// and relay the outbox, before the producer, the connections and the collector are closed.
 func (a *App) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+
+	// stop gRPC server
+	if a.GrpcServer != nil {
+		a.stopGrpcServer(ctx)
+	}
+	// stop broker consumer
+	if a.BrokerConsumer != nil {
+		if err := a.BrokerConsumer.Close(ctx); err != nil {
+			a.Logger.Error("close broker consumer", zap.Error(err))
+		}
+	}
+	// drain background workers
+	a.drainWorkers(ctx)
 	// close broker producer
 	a.BrokerProducer.Close()
 	// closing client service connections
-	a.ServiceClients.Close()
-	// stop gRPC server
-	a.GrpcServer.Stop()
+	if a.ServiceClients != nil {
+		a.ServiceClients.Close()
+	}
 
 	// database connection
 	a.DB.Close()
@@ -143,3 +340,43 @@ func (a *App) Stop() {
 	// zap logger sync
 	a.Logger.Sync()
 }
+
+// stopGrpcServer waits for in-flight RPCs until ctx is done, then cancels them
+func (a *App) stopGrpcServer(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		a.GrpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		a.Logger.Warn("gRPC server did not stop in time, cancelling in-flight RPCs")
+		a.GrpcServer.Stop()
+		<-stopped
+	}
+}
+
+// drainWorkers waits for the workers to finish their current run until ctx is done, then cancels it
+func (a *App) drainWorkers(ctx context.Context) {
+	if a.stopWorkers == nil {
+		return
+	}
+	close(a.stopWorkers)
+
+	stopped := make(chan struct{})
+	go func() {
+		a.workers.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		a.Logger.Warn("background workers did not stop in time, cancelling them")
+		a.abortWorkers()
+		<-stopped
+	}
+	a.abortWorkers()
+}
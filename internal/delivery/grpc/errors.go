@@ -10,15 +10,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var (
-	errNotFound   *entity.ErrNotFound
-	errConflict   *entity.ErrConflict
-	errValidation *entity.ErrValidation
-)
-
 func ErrorStatus(ctx context.Context, err error) *status.Status {
 	var (
-		st *status.Status
+		st                    *status.Status
+		errNotFound           *entity.ErrNotFound
+		errConflict           *entity.ErrConflict
+		errValidation         *entity.ErrValidation
+		errNoRequiredParam    *entity.ErrNoRequiredParameter
+		errPermissionDenied   *entity.ErrPermissionDenied
+		errFailedPrecondition *entity.ErrFailedPrecondition
 	)
 	switch {
 	// error not found
@@ -27,6 +27,12 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 	// error conflict
 	case errors.As(err, &errConflict):
 		st = status.New(codes.AlreadyExists, err.Error())
+	// error permission denied
+	case errors.As(err, &errPermissionDenied):
+		st = status.New(codes.PermissionDenied, err.Error())
+	// error failed precondition
+	case errors.As(err, &errFailedPrecondition):
+		st = status.New(codes.FailedPrecondition, err.Error())
 	// error validation errors
 	case errors.As(err, &errValidation):
 		st = status.New(codes.InvalidArgument, codes.InvalidArgument.String())
@@ -38,6 +44,23 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 			})
 		}
 		st, _ = st.WithDetails(br)
+	// error no required parameter
+	case errors.As(err, &errNoRequiredParam):
+		st = status.New(codes.InvalidArgument, err.Error())
+		br := &epb.BadRequest{}
+		for _, param := range errNoRequiredParam.Parameters() {
+			br.FieldViolations = append(br.FieldViolations, &epb.BadRequest_FieldViolation{
+				Field:       param,
+				Description: "required",
+			})
+		}
+		st, _ = st.WithDetails(br)
+	// error deadline exceeded
+	case errors.Is(err, context.DeadlineExceeded):
+		st = status.New(codes.DeadlineExceeded, codes.DeadlineExceeded.String())
+	// error canceled
+	case errors.Is(err, context.Canceled):
+		st = status.New(codes.Canceled, codes.Canceled.String())
 	// error internal
 	default:
 		st = status.New(codes.Internal, codes.Internal.String())
@@ -52,3 +75,16 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 func Error(ctx context.Context, err error) error {
 	return ErrorStatus(ctx, err).Err()
 }
+
+// ScrubStatus drops the details of internal errors, their reason carries SQL and driver messages
+func ScrubStatus(st *status.Status) *status.Status {
+	if st.Code() != codes.Internal && st.Code() != codes.Unknown {
+		return st
+	}
+
+	scrubbed := status.New(codes.Internal, codes.Internal.String())
+	scrubbed, _ = scrubbed.WithDetails(&epb.ErrorInfo{
+		Reason: codes.Internal.String(),
+	})
+	return scrubbed
+}
@@ -2,7 +2,11 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"time"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/kafka"
 	"dennic_user_service/internal/pkg/config"
@@ -12,6 +16,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// userCreateTopic is the topic and the consumer name the processed messages are recorded under
+const userCreateTopic = "api.user.create"
+
 type userCreateHandler struct {
 	config         *config.Config
 	brokerConsumer event.BrokerConsumer
@@ -31,10 +38,11 @@ func NewUserCreateHandler(config *config.Config,
 	}
 }
 
+// HandlerEvents registers the consumers of the handler, they start with the broker consumer
 func (h *userCreateHandler) HandlerEvents() error {
 	consumerConfig := kafka.NewConsumerConfig(
 		h.config.Kafka.Address,
-		"api.user.create",
+		userCreateTopic,
 		"1",
 		func(ctx context.Context, key, value []byte) error {
 			var user *entity.User
@@ -42,17 +50,24 @@ func (h *userCreateHandler) HandlerEvents() error {
 			if err := json.Unmarshal(value, &user); err != nil {
 				return err
 			}
+			if user == nil || user.Id == "" {
+				return errors.New("user create message without user id")
+			}
 
-			if _, err := h.userUsecase.Create(ctx, user); err != nil {
-				return err
+			// the user id identifies the message, so a redelivery is acknowledged instead of failing on the existing user
+			hash := sha256.Sum256(value)
+			msg := &entity.ProcessedMessage{
+				Consumer:    userCreateTopic,
+				Id:          user.Id,
+				PayloadHash: hex.EncodeToString(hash[:]),
+				ProcessedAt: time.Now().UTC(),
 			}
 
-			return nil
+			return h.userUsecase.CreateFromMessage(ctx, msg, user)
 		},
 	)
 
 	h.brokerConsumer.RegisterConsumer(consumerConfig)
-	h.brokerConsumer.Run()
 
 	return nil
 
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/memory_broker"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/usecase"
+	"dennic_user_service/internal/usecase/event"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+// userUsecaseStub records the users created from messages, other methods are not expected
+type userUsecaseStub struct {
+	usecase.UserStorageI
+	mu       sync.Mutex
+	messages []*entity.ProcessedMessage
+}
+
+func (u *userUsecaseStub) CreateFromMessage(ctx context.Context, msg *entity.ProcessedMessage, user *entity.User) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.messages = append(u.messages, msg)
+	return nil
+}
+
+func (u *userUsecaseStub) created() []*entity.ProcessedMessage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]*entity.ProcessedMessage(nil), u.messages...)
+}
+
+type UserCreateHandlerTestSuite struct {
+	suite.Suite
+	broker   *memory_broker.Broker
+	consumer event.BrokerConsumer
+	users    *userUsecaseStub
+}
+
+func (s *UserCreateHandlerTestSuite) SetupTest() {
+	cfg := config.New()
+	cfg.Kafka.Consumer.MaxRetries = "0"
+
+	s.broker = memory_broker.NewBroker()
+	var err error
+	s.consumer, err = memory_broker.NewConsumer(s.broker, cfg, zap.NewNop())
+	s.Suite.NoError(err)
+	s.users = &userUsecaseStub{}
+
+	s.Suite.NoError(NewUserCreateHandler(cfg, s.consumer, zap.NewNop(), s.users).HandlerEvents())
+	s.consumer.Run()
+}
+
+func (s *UserCreateHandlerTestSuite) TearDownTest() {
+	s.Suite.NoError(s.consumer.Close(context.Background()))
+}
+
+func (s *UserCreateHandlerTestSuite) TestRedeliveredMessage() {
+	value := []byte(`{"Id":"9a8bd4c1-7d2e-4a5e-9f61-3f0c1e2d7b10","FirstName":"Ali","PhoneNumber":"+998901234567","Password":"secret"}`)
+	s.broker.Publish(userCreateTopic, nil, value, nil)
+	s.broker.Publish(userCreateTopic, nil, value, nil)
+	s.Suite.Eventually(func() bool {
+		return s.broker.Offset("1", userCreateTopic) == 2
+	}, time.Second, time.Millisecond)
+
+	// both deliveries carry the same message id and hash, which is what lets the usecase acknowledge
+	// the redelivery instead of creating the user twice
+	created := s.users.created()
+	s.Suite.Len(created, 2)
+	s.Suite.Equal(userCreateTopic, created[0].Consumer)
+	s.Suite.Equal("9a8bd4c1-7d2e-4a5e-9f61-3f0c1e2d7b10", created[0].Id)
+	s.Suite.Len(created[0].PayloadHash, 64)
+	s.Suite.Equal(created[0].Id, created[1].Id)
+	s.Suite.Equal(created[0].PayloadHash, created[1].PayloadHash)
+}
+
+func (s *UserCreateHandlerTestSuite) TestMessageWithoutUserId() {
+	s.broker.Publish(userCreateTopic, nil, []byte(`{"FirstName":"Ali"}`), nil)
+	s.Suite.Eventually(func() bool {
+		return len(s.broker.Messages(userCreateTopic+".dlt")) == 1
+	}, time.Second, time.Millisecond)
+	s.Suite.Empty(s.users.created())
+}
+
+func TestUserCreateHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserCreateHandlerTestSuite))
+}
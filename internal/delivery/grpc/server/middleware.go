@@ -2,10 +2,19 @@ package server
 
 import (
 	"context"
+	grpc_errors "dennic_user_service/internal/delivery/grpc"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/app"
+	"errors"
+	"strings"
 
+	"github.com/golang-jwt/jwt/v5"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
@@ -24,13 +33,165 @@ func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnarySer
 	}
 }
 
-func UnaryInterceptorData(logger *zap.Logger) grpc.UnaryServerInterceptor {
+// UnaryErrorInterceptor converts domain errors returned by handlers to gRPC statuses,
+// errors that already carry a status are passed through
+func UnaryErrorInterceptor(environment string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			_ = md
-			//...
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, errorStatus(ctx, err, environment).Err()
 		}
+		return resp, nil
+	}
+}
+
+func StreamErrorInterceptor(environment string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return errorStatus(ss.Context(), err, environment).Err()
+		}
+		return nil
+	}
+}
+
+func errorStatus(ctx context.Context, err error, environment string) *status.Status {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = grpc_errors.ErrorStatus(ctx, err)
+	}
+	if environment == app.EnvironmentProduction {
+		st = grpc_errors.ScrubStatus(st)
+	}
+	return st
+}
+
+// publicMethods can be called without a token, the gateway calls them before a caller is known
+var publicMethods = map[string]bool{
+	"/user.AdminService/VerifyCredentials":  true,
+	"/user.AdminService/CheckField":         true,
+	"/user.AdminService/UpdateRefreshToken": true,
+	"/user.AdminService/RotateRefreshToken": true,
+	"/user.UserService/VerifyCredentials":   true,
+	"/user.UserService/CheckField":          true,
+	"/user.UserService/UpdateRefreshToken":  true,
+	"/user.UserService/RotateRefreshToken":  true,
+}
+
+// policy lists the roles allowed to call a method, methods missing from it and from publicMethods
+// are denied to every caller
+var policy = map[string][]string{
+	"/user.AdminService/Create":          {entity.RoleSuperadmin},
+	"/user.AdminService/Get":             {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.AdminService/ListAdmins":      {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.AdminService/Delete":          {entity.RoleSuperadmin},
+	"/user.AdminService/Update":          {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.AdminService/ChangePassword":  {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.AdminService/Unlock":          {entity.RoleSuperadmin},
+	"/user.AdminService/ChangeAdminRole": {entity.RoleSuperadmin},
+	"/user.AdminService/Restore":         {entity.RoleSuperadmin},
+	"/user.UserService/Create":           {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/Get":              {entity.RoleSuperadmin, entity.RoleAdmin, entity.RoleUser},
+	"/user.UserService/ListUsers":        {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/Update":           {entity.RoleSuperadmin, entity.RoleAdmin, entity.RoleUser},
+	"/user.UserService/Delete":           {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/ChangePassword":   {entity.RoleSuperadmin, entity.RoleAdmin, entity.RoleUser},
+	"/user.UserService/SearchUsers":      {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/Unlock":           {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/Restore":          {entity.RoleSuperadmin, entity.RoleAdmin},
+	"/user.UserService/ExportUserData":   {entity.RoleSuperadmin, entity.RoleAdmin, entity.RoleUser},
+}
+
+type callerClaims struct {
+	Role string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func UnaryInterceptorData(logger *zap.Logger, signingKey string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, logger, signingKey, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
 		return handler(ctx, req)
 	}
 }
+
+func StreamInterceptorData(logger *zap.Logger, signingKey string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), logger, signingKey, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// authorize puts the caller of a valid bearer token into ctx and enforces the policy of the method
+func authorize(ctx context.Context, logger *zap.Logger, signingKey, method string) (context.Context, error) {
+	var (
+		caller    entity.Caller
+		hasCaller bool
+	)
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get("authorization"); len(values) != 0 {
+			var err error
+			caller, err = parseCaller(values[0], signingKey)
+			if err != nil {
+				logger.Debug("invalid caller token", zap.String("method", method), zap.Error(err))
+			} else {
+				hasCaller = true
+				ctx = app.ContextWithCaller(ctx, caller)
+			}
+		}
+	}
+
+	if publicMethods[method] {
+		return ctx, nil
+	}
+	roles, listed := policy[method]
+	if !listed {
+		return ctx, grpc_errors.Error(ctx, entity.NewErrPermissionDenied(method))
+	}
+	if !hasCaller {
+		return ctx, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+	if !hasRole(roles, caller.Role) {
+		return ctx, grpc_errors.Error(ctx, entity.NewErrPermissionDenied(method))
+	}
+
+	return ctx, nil
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func parseCaller(authorization, signingKey string) (entity.Caller, error) {
+	var claims callerClaims
+
+	tokenString := strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	_, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(signingKey), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return entity.Caller{}, err
+	}
+	if claims.Subject == "" || claims.Role == "" {
+		return entity.Caller{}, errors.New("token has no subject or role")
+	}
+
+	return entity.Caller{
+		Id:   claims.Subject,
+		Role: claims.Role,
+	}, nil
+}
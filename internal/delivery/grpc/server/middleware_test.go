@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/app"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSigningKey = "test_signing_key"
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func (s *MiddlewareTestSuite) SetupSuite() {
+	s.interceptor = UnaryInterceptorData(zap.NewNop(), testSigningKey)
+}
+
+func (s *MiddlewareTestSuite) call(method, role string) (entity.Caller, error) {
+	ctx := context.Background()
+	if role != "" {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, callerClaims{
+			Role: role,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "caller_id",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		}).SignedString([]byte(testSigningKey))
+		s.Suite.NoError(err)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	var caller entity.Caller
+	_, err := s.interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ = app.GetCallerFromContext(ctx)
+		return nil, nil
+	})
+	return caller, err
+}
+
+func (s *MiddlewareTestSuite) TestPolicy() {
+	// superadmin can create admins
+	caller, err := s.call("/user.AdminService/Create", entity.RoleSuperadmin)
+	s.Suite.NoError(err)
+	s.Suite.Equal(caller, entity.Caller{Id: "caller_id", Role: entity.RoleSuperadmin})
+
+	// admin cannot create admins
+	_, err = s.call("/user.AdminService/Create", entity.RoleAdmin)
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	// no token on a protected method
+	_, err = s.call("/user.AdminService/Delete", "")
+	s.Suite.Equal(codes.Unauthenticated, status.Code(err))
+
+	// erasing a user is left to staff
+	_, err = s.call("/user.UserService/Delete", entity.RoleUser)
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = s.call("/user.UserService/Delete", "")
+	s.Suite.Equal(codes.Unauthenticated, status.Code(err))
+
+	// searching users is left to staff
+	_, err = s.call("/user.UserService/SearchUsers", entity.RoleAdmin)
+	s.Suite.NoError(err)
+	_, err = s.call("/user.UserService/SearchUsers", entity.RoleUser)
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	// admin profiles are left to staff
+	_, err = s.call("/user.AdminService/Get", "")
+	s.Suite.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = s.call("/user.AdminService/ListAdmins", entity.RoleUser)
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+
+	// public methods do not need a token
+	_, err = s.call("/user.UserService/VerifyCredentials", "")
+	s.Suite.NoError(err)
+	_, err = s.call("/user.AdminService/CheckField", "")
+	s.Suite.NoError(err)
+}
+
+func (s *MiddlewareTestSuite) TestUnlistedMethod() {
+	// a method missing from the policy is denied even to a superadmin
+	_, err := s.call("/user.AdminService/Unknown", entity.RoleSuperadmin)
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = s.call("/user.UserService/Unknown", "")
+	s.Suite.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (t testServerStream) Context() context.Context {
+	return t.ctx
+}
+
+func (s *MiddlewareTestSuite) TestStreamPolicy() {
+	interceptor := StreamInterceptorData(zap.NewNop(), testSigningKey)
+	info := &grpc.StreamServerInfo{FullMethod: "/user.UserService/ExportUserData", IsServerStream: true}
+
+	// no token on a protected stream
+	err := interceptor(nil, testServerStream{ctx: context.Background()}, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	s.Suite.Equal(codes.Unauthenticated, status.Code(err))
+
+	// the caller reaches the handler through the stream context
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, callerClaims{
+		Role: entity.RoleUser,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "caller_id",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}).SignedString([]byte(testSigningKey))
+	s.Suite.NoError(err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	var caller entity.Caller
+	err = interceptor(nil, testServerStream{ctx: ctx}, info, func(srv interface{}, stream grpc.ServerStream) error {
+		caller, _ = app.GetCallerFromContext(stream.Context())
+		return nil
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(entity.Caller{Id: "caller_id", Role: entity.RoleUser}, caller)
+}
+
+func (s *MiddlewareTestSuite) TestInvalidToken() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, callerClaims{
+		Role: entity.RoleSuperadmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "caller_id",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}).SignedString([]byte("other_key"))
+	s.Suite.NoError(err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	_, err = s.interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/user.AdminService/Create"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	s.Suite.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (s *MiddlewareTestSuite) TestErrorInterceptor() {
+	info := &grpc.UnaryServerInfo{FullMethod: "/user.UserService/Get"}
+	call := func(environment string, err error) *status.Status {
+		_, err = UnaryErrorInterceptor(environment)(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+		return status.Convert(err)
+	}
+
+	s.Suite.Equal(codes.NotFound, call("develop", entity.ErrorNotFound).Code())
+	s.Suite.Equal(codes.InvalidArgument, call("develop", entity.NewErrNoRequiredParameter("id")).Code())
+	s.Suite.Equal(codes.DeadlineExceeded, call("develop", fmt.Errorf("query: %w", context.DeadlineExceeded)).Code())
+	s.Suite.Equal(codes.FailedPrecondition, call("develop", entity.ErrorLastSuperadmin).Code())
+
+	// existing statuses pass through
+	s.Suite.Equal(codes.Unauthenticated, call("develop", status.Error(codes.Unauthenticated, "no token")).Code())
+
+	// internal details are only exposed outside production
+	st := call("develop", errors.New(`ERROR: column "passwrd" does not exist`))
+	s.Suite.Equal(codes.Internal, st.Code())
+	s.Suite.Contains(st.Details()[0].(*epb.ErrorInfo).Reason, "passwrd")
+
+	st = call(app.EnvironmentProduction, errors.New(`ERROR: column "passwrd" does not exist`))
+	s.Suite.Equal(codes.Internal, st.Code())
+	s.Suite.NotContains(st.Details()[0].(*epb.ErrorInfo).Reason, "passwrd")
+}
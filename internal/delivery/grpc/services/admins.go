@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/delivery/grpc/validation"
 	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/app"
 	"dennic_user_service/internal/pkg/config"
 	"dennic_user_service/internal/pkg/minio"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/usecase"
-	"dennic_user_service/internal/usecase/event"
+	"errors"
 	"time"
 
 	"go.uber.org/zap"
@@ -20,19 +22,16 @@ const (
 )
 
 type adminRPC struct {
-	logger         *zap.Logger
-	admin          usecase.AdminStorageI
-	brokerProducer event.BrokerProducer
+	logger *zap.Logger
+	admin  usecase.AdminStorageI
 }
 
 var cfg = config.New()
 
-func NewAdminRPC(logger *zap.Logger, admin usecase.AdminStorageI,
-	brokerProducer event.BrokerProducer) pb.AdminServiceServer {
+func NewAdminRPC(logger *zap.Logger, admin usecase.AdminStorageI) pb.AdminServiceServer {
 	return &adminRPC{
-		logger:         logger,
-		admin:          admin,
-		brokerProducer: brokerProducer,
+		logger: logger,
+		admin:  admin,
 	}
 }
 
@@ -40,6 +39,9 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Create")
 	defer span.End()
+	if err := validation.CreateAdmin(admin); err != nil {
+		return nil, err
+	}
 
 	reqImageUrl := minio.RemoveImageUrl(admin.ImageUrl)
 	req := entity.Admin{
@@ -85,14 +87,12 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		BirthDate:     resp.BirthDate,
 		PhoneNumber:   resp.PhoneNumber,
 		Email:         resp.Email,
-		Password:      resp.Password,
 		Gender:        resp.Gender,
 		Salary:        resp.Salary,
 		Biography:     resp.Biography,
 		StartWorkYear: resp.StartWorkYear,
 		EndWorkYear:   resp.EndWorkYear,
 		WorkYears:     resp.WorkYears,
-		RefreshToken:  resp.RefreshToken,
 		ImageUrl:      respImageUrl,
 		CreatedAt:     resp.CreatedAt.String(),
 	}, nil
@@ -102,6 +102,9 @@ func (a adminRPC) Get(ctx context.Context, req *pb.GetAdminReq) (*pb.Admin, erro
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Get")
 	defer span.End()
+	if err := validation.GetAdmin(req); err != nil {
+		return nil, err
+	}
 	resp, err := a.admin.Get(ctx, &entity.FieldValueReq{
 		Field:        req.Field,
 		Value:        req.Value,
@@ -114,26 +117,26 @@ func (a adminRPC) Get(ctx context.Context, req *pb.GetAdminReq) (*pb.Admin, erro
 	}
 	respImageUrl := minio.AddImageUrl(resp.ImageUrl, cfg.MinioService.Bucket.User)
 	response := &pb.Admin{
-		Id:            resp.Id,
-		AdminOrder:    resp.AdminOrder,
-		Role:          resp.Role,
-		FirstName:     resp.FirstName,
-		LastName:      resp.LastName,
-		BirthDate:     resp.BirthDate,
-		PhoneNumber:   resp.PhoneNumber,
-		Email:         resp.Email,
-		Password:      resp.Password,
-		Gender:        resp.Gender,
-		Salary:        resp.Salary,
-		Biography:     resp.Biography,
-		StartWorkYear: resp.StartWorkYear,
-		EndWorkYear:   resp.EndWorkYear,
-		WorkYears:     resp.WorkYears,
-		RefreshToken:  resp.RefreshToken,
-		ImageUrl:      respImageUrl,
-		CreatedAt:     resp.CreatedAt.String(),
-		UpdatedAt:     resp.UpdatedAt.String(),
-		DeletedAt:     resp.DeletedAt.String(),
+		Id:                  resp.Id,
+		AdminOrder:          resp.AdminOrder,
+		Role:                resp.Role,
+		FirstName:           resp.FirstName,
+		LastName:            resp.LastName,
+		BirthDate:           resp.BirthDate,
+		PhoneNumber:         resp.PhoneNumber,
+		Email:               resp.Email,
+		Gender:              resp.Gender,
+		Salary:              resp.Salary,
+		Biography:           resp.Biography,
+		StartWorkYear:       resp.StartWorkYear,
+		EndWorkYear:         resp.EndWorkYear,
+		WorkYears:           resp.WorkYears,
+		ImageUrl:            respImageUrl,
+		CreatedAt:           resp.CreatedAt.String(),
+		UpdatedAt:           resp.UpdatedAt.String(),
+		DeletedAt:           resp.DeletedAt.String(),
+		FailedLoginAttempts: uint64(resp.FailedLoginAttempts),
+		LockedUntil:         resp.LockedUntil.String(),
 	}
 
 	if response.UpdatedAt == "0001-01-01 00:00:00 +0000 UTC" {
@@ -142,6 +145,9 @@ func (a adminRPC) Get(ctx context.Context, req *pb.GetAdminReq) (*pb.Admin, erro
 	if response.DeletedAt == "0001-01-01 00:00:00 +0000 UTC" {
 		response.DeletedAt = ""
 	}
+	if resp.LockedUntil.IsZero() {
+		response.LockedUntil = ""
+	}
 
 	return response, nil
 }
@@ -150,6 +156,9 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ListAdmins")
 	defer span.End()
+	if err := validation.ListAdmins(req); err != nil {
+		return nil, err
+	}
 	resp, err := a.admin.List(ctx, &entity.GetAllReq{
 		Page:         req.Page,
 		Limit:        req.Limit,
@@ -157,6 +166,8 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 		Field:        req.Field,
 		Value:        req.Value,
 		OrderBy:      req.OrderBy,
+		IsLocked:     req.IsLocked,
+		PageToken:    req.PageToken,
 	})
 
 	if err != nil {
@@ -169,26 +180,26 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 	for _, in := range resp {
 		respImageUrl := minio.AddImageUrl(in.ImageUrl, cfg.MinioService.Bucket.User)
 		admin := &pb.Admin{
-			Id:            in.Id,
-			AdminOrder:    in.AdminOrder,
-			Role:          in.Role,
-			FirstName:     in.FirstName,
-			LastName:      in.LastName,
-			BirthDate:     in.BirthDate,
-			PhoneNumber:   in.PhoneNumber,
-			Email:         in.Email,
-			Password:      in.Password,
-			Gender:        in.Gender,
-			Salary:        in.Salary,
-			Biography:     in.Biography,
-			StartWorkYear: in.StartWorkYear,
-			EndWorkYear:   in.EndWorkYear,
-			WorkYears:     in.WorkYears,
-			RefreshToken:  in.RefreshToken,
-			ImageUrl:      respImageUrl,
-			CreatedAt:     in.CreatedAt.String(),
-			UpdatedAt:     in.UpdatedAt.String(),
-			DeletedAt:     in.DeletedAt.String(),
+			Id:                  in.Id,
+			AdminOrder:          in.AdminOrder,
+			Role:                in.Role,
+			FirstName:           in.FirstName,
+			LastName:            in.LastName,
+			BirthDate:           in.BirthDate,
+			PhoneNumber:         in.PhoneNumber,
+			Email:               in.Email,
+			Gender:              in.Gender,
+			Salary:              in.Salary,
+			Biography:           in.Biography,
+			StartWorkYear:       in.StartWorkYear,
+			EndWorkYear:         in.EndWorkYear,
+			WorkYears:           in.WorkYears,
+			ImageUrl:            respImageUrl,
+			CreatedAt:           in.CreatedAt.String(),
+			UpdatedAt:           in.UpdatedAt.String(),
+			DeletedAt:           in.DeletedAt.String(),
+			FailedLoginAttempts: uint64(in.FailedLoginAttempts),
+			LockedUntil:         in.LockedUntil.String(),
 		}
 		if in.UpdatedAt.String() == "0001-01-01 00:00:00 +0000 UTC" {
 			admin.UpdatedAt = ""
@@ -196,9 +207,16 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 		if in.DeletedAt.String() == "0001-01-01 00:00:00 +0000 UTC" {
 			admin.DeletedAt = ""
 		}
+		if in.LockedUntil.IsZero() {
+			admin.LockedUntil = ""
+		}
 		admins.Admins = append(admins.Admins, admin)
 		admins.Count = uint64(in.Count)
 	}
+	// a full page may be followed by more rows
+	if req.Limit >= 1 && uint64(len(resp)) == req.Limit {
+		admins.NextPageToken = resp[len(resp)-1].Cursor
+	}
 
 	return &admins, nil
 }
@@ -207,12 +225,19 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Update")
 	defer span.End()
+	if err := validation.UpdateAdmin(admin); err != nil {
+		return nil, err
+	}
+	if err := a.authorizeUpdate(ctx, admin); err != nil {
+		a.logger.Error("update admin error", zap.Error(err))
+		return nil, err
+	}
 	reqImageUrl := minio.RemoveImageUrl(admin.ImageUrl)
 	req := entity.Admin{
 		Id:            admin.Id,
 		FirstName:     admin.FirstName,
-		LastName:      admin.FirstName,
-		BirthDate:     admin.Biography,
+		LastName:      admin.LastName,
+		BirthDate:     admin.BirthDate,
 		Gender:        admin.Gender,
 		Salary:        admin.Salary,
 		Biography:     admin.Biography,
@@ -250,14 +275,12 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		BirthDate:     resp.BirthDate,
 		PhoneNumber:   resp.PhoneNumber,
 		Email:         resp.Email,
-		Password:      resp.Password,
 		Gender:        resp.Gender,
 		Salary:        resp.Salary,
 		Biography:     resp.Biography,
 		StartWorkYear: resp.StartWorkYear,
 		EndWorkYear:   resp.EndWorkYear,
 		WorkYears:     resp.WorkYears,
-		RefreshToken:  resp.RefreshToken,
 		ImageUrl:      respImageUrl,
 		CreatedAt:     resp.CreatedAt.String(),
 		UpdatedAt:     resp.UpdatedAt.String(),
@@ -270,6 +293,9 @@ func (a adminRPC) Delete(ctx context.Context, req *pb.DeleteAdminReq) (resp *pb.
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Delete")
 	defer span.End()
+	if err := validation.DeleteAdmin(req); err != nil {
+		return nil, err
+	}
 	status, err := a.admin.Delete(ctx, &entity.FieldValueReq{
 		Field:        req.Field,
 		Value:        req.Value,
@@ -291,6 +317,9 @@ func (a adminRPC) CheckField(ctx context.Context, req *pb.CheckAdminFieldReq) (*
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"CheckField")
 	defer span.End()
+	if err := validation.CheckAdminField(req); err != nil {
+		return nil, err
+	}
 	reqAdmin := entity.CheckFieldReq{
 		Value: req.Value,
 		Field: req.Field,
@@ -312,6 +341,13 @@ func (a adminRPC) ChangePassword(ctx context.Context, phone *pb.ChangeAdminPassw
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ChangePassword")
 	defer span.End()
+	if err := validation.ChangeAdminPassword(phone); err != nil {
+		return nil, err
+	}
+	if err := a.authorizeSelf(ctx, map[string]string{"email": phone.Email, "phone_number": phone.PhoneNumber}); err != nil {
+		a.logger.Error("change admin password error", zap.Error(err))
+		return nil, err
+	}
 	req := entity.ChangeAdminPasswordReq{
 		Email:       phone.Email,
 		PhoneNumber: phone.PhoneNumber,
@@ -333,9 +369,13 @@ func (a adminRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTo
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"UpdateRefreshToken")
 	defer span.End()
+	if err := validation.UpdateAdminRefreshToken(id); err != nil {
+		return nil, err
+	}
 	req := entity.UpdateRefreshTokenReq{
 		Id:           id.Id,
 		RefreshToken: id.RefreshToken,
+		DeviceId:     id.DeviceId,
 	}
 	status, err := a.admin.UpdateRefreshToken(ctx, &req)
 	if err != nil {
@@ -349,3 +389,171 @@ func (a adminRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTo
 
 	return resp, nil
 }
+
+func (a adminRPC) RotateRefreshToken(ctx context.Context, req *pb.RotateRefreshTokenAdminReq) (*pb.RotateRefreshTokenAdminResp, error) {
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"RotateRefreshToken")
+	defer span.End()
+	if err := validation.RotateAdminRefreshToken(req); err != nil {
+		return nil, err
+	}
+	resp, err := a.admin.RotateRefreshToken(ctx, &entity.RotateRefreshTokenReq{
+		RefreshToken:    req.RefreshToken,
+		NewRefreshToken: req.NewRefreshToken,
+	})
+	if err != nil {
+		a.logger.Error("rotate admin refresh token error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.RotateRefreshTokenAdminResp{
+		Status:        resp.Status,
+		AdminId:       resp.AccountId,
+		ReuseDetected: resp.ReuseDetected,
+	}, nil
+}
+
+func (a adminRPC) VerifyCredentials(ctx context.Context, req *pb.VerifyAdminCredentialsReq) (*pb.VerifyAdminCredentialsResp, error) {
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"VerifyCredentials")
+	defer span.End()
+	if err := validation.VerifyAdminCredentials(req); err != nil {
+		return nil, err
+	}
+	resp, err := a.admin.VerifyCredentials(ctx, &entity.VerifyCredentialsReq{
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		Password:    req.Password,
+	})
+	if err != nil {
+		a.logger.Error("verify admin credentials error", zap.Error(err))
+		return nil, err
+	}
+
+	response := &pb.VerifyAdminCredentialsResp{
+		Valid:  resp.Valid,
+		Id:     resp.Id,
+		Role:   resp.Role,
+		Status: resp.Status,
+	}
+	if !resp.LockedUntil.IsZero() {
+		response.LockedUntil = resp.LockedUntil.String()
+	}
+
+	return response, nil
+}
+
+func (a adminRPC) Unlock(ctx context.Context, req *pb.UnlockAdminReq) (*pb.UnlockAdminResp, error) {
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Unlock")
+	defer span.End()
+	if err := validation.UnlockAdmin(req); err != nil {
+		return nil, err
+	}
+	status, err := a.admin.Unlock(ctx, &entity.UnlockAccountReq{
+		Id: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockAdminResp{
+		Status: status.Status,
+	}, nil
+}
+
+func (a adminRPC) Restore(ctx context.Context, req *pb.RestoreAdminReq) (*pb.RestoreAdminResp, error) {
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Restore")
+	defer span.End()
+	if err := validation.RestoreAdmin(req); err != nil {
+		return nil, err
+	}
+	status, err := a.admin.Restore(ctx, &entity.RestoreAccountReq{
+		Id:        req.Id,
+		UpdatedAt: time.Now().Add(time.Hour * 5),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreAdminResp{
+		Status: status.Status,
+	}, nil
+}
+
+func (a adminRPC) ChangeAdminRole(ctx context.Context, req *pb.ChangeAdminRoleReq) (*pb.ChangeAdminRoleResp, error) {
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ChangeAdminRole")
+	defer span.End()
+	if err := validation.ChangeAdminRole(req); err != nil {
+		return nil, err
+	}
+	status, err := a.admin.ChangeRole(ctx, &entity.ChangeAdminRoleReq{
+		Id:        req.Id,
+		Role:      req.Role,
+		UpdatedAt: time.Now().Add(time.Hour * 5),
+	})
+	if err != nil {
+		a.logger.Error("change admin role error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.ChangeAdminRoleResp{
+		Status: status.Status,
+	}, nil
+}
+
+// authorizeUpdate lets superadmins update any admin, other admins may only update
+// their own profile and cannot change their salary
+func (a adminRPC) authorizeUpdate(ctx context.Context, admin *pb.Admin) error {
+	caller, _ := app.GetCallerFromContext(ctx)
+	if caller.Role == entity.RoleSuperadmin {
+		return nil
+	}
+	if caller.Id != admin.Id {
+		return entity.NewErrPermissionDenied("updating another admin")
+	}
+
+	current, err := a.admin.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: admin.Id,
+	})
+	if err != nil {
+		return err
+	}
+	if current.Salary != admin.Salary {
+		return entity.NewErrPermissionDenied("changing salary")
+	}
+
+	return nil
+}
+
+// authorizeSelf lets superadmins act on any admin, other admins only on the account the fields point to
+func (a adminRPC) authorizeSelf(ctx context.Context, fields map[string]string) error {
+	caller, _ := app.GetCallerFromContext(ctx)
+	if caller.Role == entity.RoleSuperadmin {
+		return nil
+	}
+
+	for field, value := range fields {
+		if value == "" {
+			continue
+		}
+		target, err := a.admin.Get(ctx, &entity.FieldValueReq{
+			Field: field,
+			Value: value,
+		})
+		if errors.Is(err, entity.ErrorNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if target.Id != caller.Id {
+			return entity.NewErrPermissionDenied("acting on another admin")
+		}
+	}
+
+	return nil
+}
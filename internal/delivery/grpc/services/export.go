@@ -0,0 +1,114 @@
+package services
+
+import (
+	"dennic_user_service/genproto/booking_service"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/minio"
+	"time"
+)
+
+const (
+	// userDataExportVersion is bumped whenever the document layout changes in a way readers must know about
+	userDataExportVersion = 1
+	// exportChunkSize bounds the size of one streamed message
+	exportChunkSize = 64 << 10
+)
+
+// userDataDocument is the JSON document ExportUserData streams to the caller
+type userDataDocument struct {
+	Version       int                  `json:"version"`
+	GeneratedAt   time.Time            `json:"generated_at"`
+	Profile       exportProfile        `json:"profile"`
+	LoginAttempts []exportLoginAttempt `json:"login_attempts"`
+	Sessions      []exportSession      `json:"sessions"`
+	Booking       *exportBooking       `json:"booking,omitempty"`
+}
+
+type exportProfile struct {
+	Id                  string     `json:"id"`
+	FirstName           string     `json:"first_name"`
+	LastName            string     `json:"last_name"`
+	BirthDate           string     `json:"birth_date"`
+	PhoneNumber         string     `json:"phone_number"`
+	Gender              string     `json:"gender"`
+	ImageUrl            string     `json:"image_url,omitempty"`
+	FailedLoginAttempts int64      `json:"failed_login_attempts"`
+	LockedUntil         *time.Time `json:"locked_until,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+type exportLoginAttempt struct {
+	Identifier string    `json:"identifier"`
+	Success    bool      `json:"success"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// exportSession describes an issued refresh token, the token itself is never stored and never exported
+type exportSession struct {
+	Id        string     `json:"id"`
+	FamilyId  string     `json:"family_id"`
+	DeviceId  string     `json:"device_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// exportBooking holds what the booking service stores about the user, Patient is nil when it knows no such patient
+type exportBooking struct {
+	Patient *booking_service.Patient `json:"patient"`
+}
+
+func newUserDataDocument(export *entity.UserDataExport) *userDataDocument {
+	user := export.User
+	document := &userDataDocument{
+		Version:     userDataExportVersion,
+		GeneratedAt: time.Now().UTC(),
+		Profile: exportProfile{
+			Id:                  user.Id,
+			FirstName:           user.FirstName,
+			LastName:            user.LastName,
+			BirthDate:           user.BirthDate,
+			PhoneNumber:         user.PhoneNumber,
+			Gender:              user.Gender,
+			FailedLoginAttempts: user.FailedLoginAttempts,
+			LockedUntil:         optionalTime(user.LockedUntil),
+			CreatedAt:           user.CreatedAt,
+			UpdatedAt:           optionalTime(user.UpdatedAt),
+		},
+		LoginAttempts: make([]exportLoginAttempt, 0, len(export.LoginAttempts)),
+		Sessions:      make([]exportSession, 0, len(export.Sessions)),
+	}
+	if user.ImageUrl != "" {
+		document.Profile.ImageUrl = minio.AddImageUrl(user.ImageUrl, cfg.MinioService.Bucket.User)
+	}
+
+	for _, attempt := range export.LoginAttempts {
+		document.LoginAttempts = append(document.LoginAttempts, exportLoginAttempt{
+			Identifier: attempt.Identifier,
+			Success:    attempt.Success,
+			CreatedAt:  attempt.CreatedAt,
+		})
+	}
+	for _, session := range export.Sessions {
+		document.Sessions = append(document.Sessions, exportSession{
+			Id:        session.Id,
+			FamilyId:  session.FamilyId,
+			DeviceId:  session.DeviceId,
+			CreatedAt: session.CreatedAt,
+			ExpiresAt: session.ExpiresAt,
+			RotatedAt: optionalTime(session.RotatedAt),
+			RevokedAt: optionalTime(session.RevokedAt),
+		})
+	}
+
+	return document
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"dennic_user_service/genproto/booking_service"
+	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/grpc_service_clients"
+	"dennic_user_service/internal/pkg/app"
+	"dennic_user_service/internal/usecase"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userUsecaseStub returns export from Export and user from Get, other methods are not expected
+type userUsecaseStub struct {
+	usecase.UserStorageI
+	export *entity.UserDataExport
+	user   *entity.User
+}
+
+func (u *userUsecaseStub) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error) {
+	return u.user, nil
+}
+
+func (u *userUsecaseStub) ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error) {
+	return &entity.ChangePasswordResp{Status: true}, nil
+}
+
+func (u *userUsecaseStub) Export(ctx context.Context, req *entity.ExportUserDataReq) (*entity.UserDataExport, error) {
+	return u.export, nil
+}
+
+// patientsServiceStub knows patients by phone number and records the lookups
+type patientsServiceStub struct {
+	booking_service.PatientsServiceClient
+	patients map[string]*booking_service.Patient
+	requests []*booking_service.PatientFieldValueReq
+}
+
+func (p *patientsServiceStub) GetPatient(ctx context.Context, in *booking_service.PatientFieldValueReq, opts ...grpc.CallOption) (*booking_service.Patient, error) {
+	p.requests = append(p.requests, in)
+	if patient, ok := p.patients[in.Value]; ok && in.Field == "phone_number" {
+		return patient, nil
+	}
+	return nil, status.Error(codes.NotFound, "patient not found")
+}
+
+type serviceClientsStub struct {
+	grpc_service_clients.ServiceClients
+	patients *patientsServiceStub
+}
+
+func (s serviceClientsStub) PatientsService() booking_service.PatientsServiceClient {
+	return s.patients
+}
+
+// exportStreamStub collects the streamed chunks
+type exportStreamStub struct {
+	pb.UserService_ExportUserDataServer
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (e *exportStreamStub) Context() context.Context {
+	return e.ctx
+}
+
+func (e *exportStreamStub) Send(resp *pb.ExportUserDataResp) error {
+	e.chunks = append(e.chunks, resp.Chunk)
+	return nil
+}
+
+type ExportUserDataTestSuite struct {
+	suite.Suite
+	user     *entity.User
+	patients *patientsServiceStub
+	rpc      pb.UserServiceServer
+}
+
+func (s *ExportUserDataTestSuite) SetupTest() {
+	s.user = &entity.User{
+		Id:          "6f1f9b8e-2d5c-4a53-9f0e-1c0b7c3f2a11",
+		FirstName:   "firstname",
+		LastName:    "lastname",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: "+998994767316",
+		Gender:      "male",
+		CreatedAt:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	attempts := make([]*entity.LoginAttempt, 0, 2000)
+	for i := 0; i < cap(attempts); i++ {
+		attempts = append(attempts, &entity.LoginAttempt{
+			Identifier: strings.Repeat("x", 50),
+			CreatedAt:  s.user.CreatedAt,
+		})
+	}
+
+	s.patients = &patientsServiceStub{patients: map[string]*booking_service.Patient{
+		s.user.PhoneNumber: {Id: "patient_id", PhoneNumber: s.user.PhoneNumber},
+	}}
+	s.rpc = NewUserRPC(zap.NewNop(), &userUsecaseStub{export: &entity.UserDataExport{
+		User:          s.user,
+		LoginAttempts: attempts,
+	}}, serviceClientsStub{patients: s.patients})
+}
+
+func (s *ExportUserDataTestSuite) export(req *pb.ExportUserDataReq) (*userDataDocument, error) {
+	ctx := app.ContextWithCaller(context.Background(), entity.Caller{Id: s.user.Id, Role: entity.RoleUser})
+	stream := &exportStreamStub{ctx: ctx}
+	if err := s.rpc.ExportUserData(req, stream); err != nil {
+		return nil, err
+	}
+
+	for _, chunk := range stream.chunks {
+		s.Suite.LessOrEqual(len(chunk), exportChunkSize)
+	}
+	var document userDataDocument
+	s.Suite.NoError(json.Unmarshal(bytes.Join(stream.chunks, nil), &document))
+	return &document, nil
+}
+
+// test func
+func (s *ExportUserDataTestSuite) TestExportUserData() {
+	document, err := s.export(&pb.ExportUserDataReq{Id: s.user.Id, IncludeBooking: true})
+	s.Suite.NoError(err)
+	s.Suite.Equal(userDataExportVersion, document.Version)
+	s.Suite.Equal(s.user.Id, document.Profile.Id)
+	s.Suite.Len(document.LoginAttempts, 2000)
+
+	// the patient is looked up by the phone number of the user, not by the user id
+	s.Suite.Equal([]*booking_service.PatientFieldValueReq{{Field: "phone_number", Value: s.user.PhoneNumber}}, s.patients.requests)
+	s.Suite.NotNil(document.Booking)
+	s.Suite.Equal("patient_id", document.Booking.Patient.Id)
+
+	// booking data is only requested on demand
+	document, err = s.export(&pb.ExportUserDataReq{Id: s.user.Id})
+	s.Suite.NoError(err)
+	s.Suite.Nil(document.Booking)
+	s.Suite.Len(s.patients.requests, 1)
+}
+
+func (s *ExportUserDataTestSuite) TestExportAnotherUser() {
+	var denied *entity.ErrPermissionDenied
+	_, err := s.export(&pb.ExportUserDataReq{Id: "0b7c3f2a-1c0b-4a53-9f0e-6f1f9b8e2d5c"})
+	s.Suite.ErrorAs(err, &denied)
+}
+
+func TestExportUserDataTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportUserDataTestSuite))
+}
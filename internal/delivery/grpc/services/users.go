@@ -2,15 +2,21 @@ package services
 
 import (
 	"context"
+	"dennic_user_service/genproto/booking_service"
 	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/delivery/grpc/validation"
 	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/grpc_service_clients"
+	"dennic_user_service/internal/pkg/app"
 	"dennic_user_service/internal/pkg/minio"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/usecase"
-	"dennic_user_service/internal/usecase/event"
+	"encoding/json"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -21,15 +27,14 @@ const (
 type userRPC struct {
 	logger         *zap.Logger
 	user           usecase.UserStorageI
-	brokerProducer event.BrokerProducer
+	serviceClients grpc_service_clients.ServiceClients
 }
 
-func NewUserRPC(logger *zap.Logger, user usecase.UserStorageI,
-	brokerProducer event.BrokerProducer) pb.UserServiceServer {
+func NewUserRPC(logger *zap.Logger, user usecase.UserStorageI, serviceClients grpc_service_clients.ServiceClients) pb.UserServiceServer {
 	return &userRPC{
+		serviceClients: serviceClients,
 		logger:         logger,
 		user:           user,
-		brokerProducer: brokerProducer,
 	}
 }
 
@@ -37,6 +42,9 @@ func (u userRPC) Create(ctx context.Context, user *pb.User) (*pb.User, error) {
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Create")
 	defer span.End()
+	if err := validation.CreateUser(user); err != nil {
+		return nil, err
+	}
 
 	reqImageUrl := minio.RemoveImageUrl(user.ImageUrl)
 	req := entity.User{
@@ -65,16 +73,14 @@ func (u userRPC) Create(ctx context.Context, user *pb.User) (*pb.User, error) {
 	}
 
 	return &pb.User{
-		Id:           resp.Id,
-		UserOrder:    resp.UserOrder,
-		FirstName:    resp.FirstName,
-		LastName:     resp.LastName,
-		BirthDate:    resp.BirthDate,
-		PhoneNumber:  resp.PhoneNumber,
-		Password:     resp.Password,
-		Gender:       resp.Gender,
-		RefreshToken: resp.RefreshToken,
-		CreatedAt:    resp.CreatedAt.String(),
+		Id:          resp.Id,
+		UserOrder:   resp.UserOrder,
+		FirstName:   resp.FirstName,
+		LastName:    resp.LastName,
+		BirthDate:   resp.BirthDate,
+		PhoneNumber: resp.PhoneNumber,
+		Gender:      resp.Gender,
+		CreatedAt:   resp.CreatedAt.String(),
 	}, nil
 }
 
@@ -82,6 +88,9 @@ func (u userRPC) Get(ctx context.Context, req *pb.GetUserReq) (*pb.User, error)
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Get")
 	defer span.End()
+	if err := validation.GetUser(req); err != nil {
+		return nil, err
+	}
 	var (
 		respImageUrl string
 	)
@@ -94,23 +103,26 @@ func (u userRPC) Get(ctx context.Context, req *pb.GetUserReq) (*pb.User, error)
 	if err != nil {
 		return nil, err
 	}
+	if caller, _ := app.GetCallerFromContext(ctx); caller.Role == entity.RoleUser && caller.Id != resp.Id {
+		return nil, entity.NewErrPermissionDenied("reading another user")
+	}
 	if resp.ImageUrl != "" {
 		respImageUrl = minio.AddImageUrl(resp.ImageUrl, cfg.MinioService.Bucket.User)
 	}
 	response := &pb.User{
-		Id:           resp.Id,
-		UserOrder:    resp.UserOrder,
-		FirstName:    resp.FirstName,
-		LastName:     resp.LastName,
-		BirthDate:    resp.BirthDate,
-		PhoneNumber:  resp.PhoneNumber,
-		Password:     resp.Password,
-		Gender:       resp.Gender,
-		RefreshToken: resp.RefreshToken,
-		ImageUrl:     respImageUrl,
-		CreatedAt:    resp.CreatedAt.String(),
-		UpdatedAt:    resp.UpdatedAt.String(),
-		DeletedAt:    resp.DeletedAt.String(),
+		Id:                  resp.Id,
+		UserOrder:           resp.UserOrder,
+		FirstName:           resp.FirstName,
+		LastName:            resp.LastName,
+		BirthDate:           resp.BirthDate,
+		PhoneNumber:         resp.PhoneNumber,
+		Gender:              resp.Gender,
+		ImageUrl:            respImageUrl,
+		CreatedAt:           resp.CreatedAt.String(),
+		UpdatedAt:           resp.UpdatedAt.String(),
+		DeletedAt:           resp.DeletedAt.String(),
+		FailedLoginAttempts: uint64(resp.FailedLoginAttempts),
+		LockedUntil:         resp.LockedUntil.String(),
 	}
 
 	if response.UpdatedAt == "0001-01-01 00:00:00 +0000 UTC" {
@@ -119,6 +131,9 @@ func (u userRPC) Get(ctx context.Context, req *pb.GetUserReq) (*pb.User, error)
 	if response.DeletedAt == "0001-01-01 00:00:00 +0000 UTC" {
 		response.DeletedAt = ""
 	}
+	if resp.LockedUntil.IsZero() {
+		response.LockedUntil = ""
+	}
 
 	return response, nil
 }
@@ -127,6 +142,9 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ListUsers")
 	defer span.End()
+	if err := validation.ListUsers(req); err != nil {
+		return nil, err
+	}
 	resp, err := u.user.List(ctx, &entity.GetAllReq{
 		Page:         req.Page,
 		Limit:        req.Limit,
@@ -134,6 +152,9 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 		Field:        req.Field,
 		Value:        req.Value,
 		OrderBy:      req.OrderBy,
+		IsLocked:     req.IsLocked,
+		PageToken:    req.PageToken,
+		Filter:       userFilter(req.Filter),
 	})
 
 	if err != nil {
@@ -153,19 +174,19 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 			respImageUrl = minio.RemoveImageUrl(in.ImageUrl)
 		}
 		user := &pb.User{
-			Id:           in.Id,
-			UserOrder:    in.UserOrder,
-			FirstName:    in.FirstName,
-			LastName:     in.LastName,
-			BirthDate:    in.BirthDate,
-			PhoneNumber:  in.PhoneNumber,
-			Password:     in.Password,
-			Gender:       in.Gender,
-			RefreshToken: in.RefreshToken,
-			ImageUrl:     respImageUrl,
-			CreatedAt:    in.CreatedAt.String(),
-			UpdatedAt:    in.UpdatedAt.String(),
-			DeletedAt:    in.DeletedAt.String(),
+			Id:                  in.Id,
+			UserOrder:           in.UserOrder,
+			FirstName:           in.FirstName,
+			LastName:            in.LastName,
+			BirthDate:           in.BirthDate,
+			PhoneNumber:         in.PhoneNumber,
+			Gender:              in.Gender,
+			ImageUrl:            respImageUrl,
+			CreatedAt:           in.CreatedAt.String(),
+			UpdatedAt:           in.UpdatedAt.String(),
+			DeletedAt:           in.DeletedAt.String(),
+			FailedLoginAttempts: uint64(in.FailedLoginAttempts),
+			LockedUntil:         in.LockedUntil.String(),
 		}
 
 		if in.UpdatedAt.String() == "0001-01-01 00:00:00 +0000 UTC" {
@@ -174,9 +195,16 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 		if in.DeletedAt.String() == "0001-01-01 00:00:00 +0000 UTC" {
 			user.DeletedAt = ""
 		}
+		if in.LockedUntil.IsZero() {
+			user.LockedUntil = ""
+		}
 		users.Users = append(users.Users, user)
 		users.Count = uint64(in.Count)
 	}
+	// a full page may be followed by more rows
+	if req.Limit >= 1 && uint64(len(resp)) == req.Limit {
+		users.NextPageToken = resp[len(resp)-1].Cursor
+	}
 
 	return &users, nil
 }
@@ -185,6 +213,12 @@ func (u userRPC) Update(ctx context.Context, user *pb.User) (*pb.User, error) {
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Update")
 	defer span.End()
+	if err := validation.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	if caller, _ := app.GetCallerFromContext(ctx); caller.Role == entity.RoleUser && caller.Id != user.Id {
+		return nil, entity.NewErrPermissionDenied("updating another user")
+	}
 	reqImageUrl := minio.RemoveImageUrl(user.ImageUrl)
 	req := entity.User{
 		Id:        user.Id,
@@ -216,18 +250,16 @@ func (u userRPC) Update(ctx context.Context, user *pb.User) (*pb.User, error) {
 		respImageUrl = minio.AddImageUrl(resp.ImageUrl, cfg.MinioService.Bucket.User)
 	}
 	response := &pb.User{
-		Id:           resp.Id,
-		UserOrder:    resp.UserOrder,
-		FirstName:    resp.FirstName,
-		LastName:     resp.LastName,
-		BirthDate:    resp.BirthDate,
-		PhoneNumber:  resp.LastName,
-		Password:     resp.Password,
-		Gender:       resp.Gender,
-		RefreshToken: resp.RefreshToken,
-		ImageUrl:     respImageUrl,
-		CreatedAt:    resp.CreatedAt.String(),
-		UpdatedAt:    resp.UpdatedAt.String(),
+		Id:          resp.Id,
+		UserOrder:   resp.UserOrder,
+		FirstName:   resp.FirstName,
+		LastName:    resp.LastName,
+		BirthDate:   resp.BirthDate,
+		PhoneNumber: resp.PhoneNumber,
+		Gender:      resp.Gender,
+		ImageUrl:    respImageUrl,
+		CreatedAt:   resp.CreatedAt.String(),
+		UpdatedAt:   resp.UpdatedAt.String(),
 	}
 	return response, nil
 }
@@ -236,10 +268,21 @@ func (u userRPC) Delete(ctx context.Context, req *pb.DeleteUserReq) (resp *pb.Ch
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Delete")
 	defer span.End()
+	if err := validation.DeleteUser(req); err != nil {
+		return nil, err
+	}
+	if req.Mode == entity.DeleteModeAnonymize {
+		return u.anonymize(ctx, req.Value)
+	}
+	// mode takes precedence over the legacy is_active flag
+	hardDelete := req.IsActive
+	if req.Mode != "" {
+		hardDelete = req.Mode == entity.DeleteModeHard
+	}
 	status, err := u.user.Delete(ctx, &entity.FieldValueReq{
 		Field:        req.Field,
 		Value:        req.Value,
-		DeleteStatus: req.IsActive,
+		DeleteStatus: hardDelete,
 	})
 	if err != nil {
 		return nil, err
@@ -251,10 +294,29 @@ func (u userRPC) Delete(ctx context.Context, req *pb.DeleteUserReq) (resp *pb.Ch
 	return resp, nil
 }
 
+// anonymize scrubs the personal data of the user with the given id,
+// other services keep their records and only lose the personal data behind the id
+func (u userRPC) anonymize(ctx context.Context, id string) (*pb.CheckDeleteUserResp, error) {
+	status, err := u.user.Anonymize(ctx, &entity.AnonymizeAccountReq{
+		Id:           id,
+		AnonymizedAt: time.Now().Add(time.Hour * 5),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckDeleteUserResp{
+		Status: status.Status,
+	}, nil
+}
+
 func (u userRPC) CheckField(ctx context.Context, req *pb.CheckFieldUserReq) (*pb.CheckFieldUserResp, error) {
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"CheckField")
 	defer span.End()
+	if err := validation.CheckUserField(req); err != nil {
+		return nil, err
+	}
 	reqUser := entity.CheckFieldReq{
 		Value: req.Value,
 		Field: req.Field,
@@ -275,6 +337,22 @@ func (u userRPC) ChangePassword(ctx context.Context, phone *pb.ChangeUserPasswor
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ChangePassword")
 	defer span.End()
+	if err := validation.ChangeUserPassword(phone); err != nil {
+		return nil, err
+	}
+	// users may only change the password of the account they are logged in to
+	if caller, _ := app.GetCallerFromContext(ctx); caller.Role == entity.RoleUser {
+		target, err := u.user.Get(ctx, &entity.FieldValueReq{
+			Field: "phone_number",
+			Value: phone.PhoneNumber,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if target.Id != caller.Id {
+			return nil, entity.NewErrPermissionDenied("changing the password of another user")
+		}
+	}
 	req := entity.ChangeUserPasswordReq{
 		PhoneNumber: phone.PhoneNumber,
 		Password:    phone.Password,
@@ -294,9 +372,13 @@ func (u userRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTok
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"UpdateRefreshToken")
 	defer span.End()
+	if err := validation.UpdateUserRefreshToken(id); err != nil {
+		return nil, err
+	}
 	req := entity.UpdateRefreshTokenReq{
 		Id:           id.Id,
 		RefreshToken: id.RefreshToken,
+		DeviceId:     id.DeviceId,
 	}
 	status, err := u.user.UpdateRefreshToken(ctx, &req)
 	if err != nil {
@@ -309,3 +391,218 @@ func (u userRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTok
 
 	return resp, nil
 }
+
+func (u userRPC) RotateRefreshToken(ctx context.Context, req *pb.RotateRefreshTokenUserReq) (*pb.RotateRefreshTokenUserResp, error) {
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"RotateRefreshToken")
+	defer span.End()
+	if err := validation.RotateUserRefreshToken(req); err != nil {
+		return nil, err
+	}
+	resp, err := u.user.RotateRefreshToken(ctx, &entity.RotateRefreshTokenReq{
+		RefreshToken:    req.RefreshToken,
+		NewRefreshToken: req.NewRefreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateRefreshTokenUserResp{
+		Status:        resp.Status,
+		UserId:        resp.AccountId,
+		ReuseDetected: resp.ReuseDetected,
+	}, nil
+}
+
+func (u userRPC) VerifyCredentials(ctx context.Context, req *pb.VerifyUserCredentialsReq) (*pb.VerifyUserCredentialsResp, error) {
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"VerifyCredentials")
+	defer span.End()
+	if err := validation.VerifyUserCredentials(req); err != nil {
+		return nil, err
+	}
+	resp, err := u.user.VerifyCredentials(ctx, &entity.VerifyCredentialsReq{
+		PhoneNumber: req.PhoneNumber,
+		Password:    req.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.VerifyUserCredentialsResp{
+		Valid:  resp.Valid,
+		Id:     resp.Id,
+		Role:   resp.Role,
+		Status: resp.Status,
+	}
+	if !resp.LockedUntil.IsZero() {
+		response.LockedUntil = resp.LockedUntil.String()
+	}
+
+	return response, nil
+}
+
+func (u userRPC) Unlock(ctx context.Context, req *pb.UnlockUserReq) (*pb.UnlockUserResp, error) {
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Unlock")
+	defer span.End()
+	if err := validation.UnlockUser(req); err != nil {
+		return nil, err
+	}
+	status, err := u.user.Unlock(ctx, &entity.UnlockAccountReq{
+		Id: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnlockUserResp{
+		Status: status.Status,
+	}, nil
+}
+
+func (u userRPC) Restore(ctx context.Context, req *pb.RestoreUserReq) (*pb.RestoreUserResp, error) {
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Restore")
+	defer span.End()
+	if err := validation.RestoreUser(req); err != nil {
+		return nil, err
+	}
+	status, err := u.user.Restore(ctx, &entity.RestoreAccountReq{
+		Id:        req.Id,
+		UpdatedAt: time.Now().Add(time.Hour * 5),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreUserResp{
+		Status: status.Status,
+	}, nil
+}
+
+func (u userRPC) SearchUsers(ctx context.Context, req *pb.SearchUsersReq) (*pb.SearchUsersResp, error) {
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"SearchUsers")
+	defer span.End()
+	if err := validation.SearchUsers(req); err != nil {
+		return nil, err
+	}
+	resp, err := u.user.Search(ctx, &entity.SearchUsersReq{
+		Query: req.Query,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var users pb.SearchUsersResp
+	for _, in := range resp {
+		user := &pb.User{
+			Id:                  in.Id,
+			UserOrder:           in.UserOrder,
+			FirstName:           in.FirstName,
+			LastName:            in.LastName,
+			BirthDate:           in.BirthDate,
+			PhoneNumber:         in.PhoneNumber,
+			Gender:              in.Gender,
+			ImageUrl:            minio.RemoveImageUrl(in.ImageUrl),
+			CreatedAt:           in.CreatedAt.String(),
+			UpdatedAt:           in.UpdatedAt.String(),
+			FailedLoginAttempts: uint64(in.FailedLoginAttempts),
+			LockedUntil:         in.LockedUntil.String(),
+		}
+		if in.ImageUrl != "" {
+			user.ImageUrl = minio.AddImageUrl(in.ImageUrl, cfg.MinioService.Bucket.User)
+		}
+		if in.UpdatedAt.IsZero() {
+			user.UpdatedAt = ""
+		}
+		if in.LockedUntil.IsZero() {
+			user.LockedUntil = ""
+		}
+		users.Users = append(users.Users, user)
+	}
+
+	return &users, nil
+}
+
+// ExportUserData streams everything stored about a user as a versioned JSON document split into chunks.
+// Users may only export their own data.
+func (u userRPC) ExportUserData(req *pb.ExportUserDataReq, stream pb.UserService_ExportUserDataServer) error {
+	ctx, span := otlp.Start(stream.Context(), UserServiceName, UserSpanName+"ExportUserData")
+	defer span.End()
+	if err := validation.ExportUserData(req); err != nil {
+		return err
+	}
+	if caller, _ := app.GetCallerFromContext(ctx); caller.Role == entity.RoleUser && caller.Id != req.Id {
+		return entity.NewErrPermissionDenied("exporting another user")
+	}
+
+	export, err := u.user.Export(ctx, &entity.ExportUserDataReq{Id: req.Id})
+	if err != nil {
+		return err
+	}
+	document := newUserDataDocument(export)
+
+	if req.IncludeBooking {
+		document.Booking = &exportBooking{}
+		// the booking service knows the user as the patient with the same phone number,
+		// an anonymized user has none left to match
+		if export.User.PhoneNumber != "" {
+			patient, err := u.serviceClients.PatientsService().GetPatient(ctx, &booking_service.PatientFieldValueReq{
+				Field: "phone_number",
+				Value: export.User.PhoneNumber,
+			})
+			if err != nil && status.Code(err) != codes.NotFound {
+				u.logger.Error("export user data, get booking patient error", zap.Error(err))
+				return err
+			}
+			document.Booking.Patient = patient
+		}
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		n := exportChunkSize
+		if len(data) < n {
+			n = len(data)
+		}
+		if err := stream.Send(&pb.ExportUserDataResp{Chunk: data[:n]}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
+
+// userFilter maps an already validated list filter, the created_to date is made inclusive
+func userFilter(filter *pb.UserFilter) *entity.UserFilter {
+	if filter == nil {
+		return nil
+	}
+	parse := func(value string) time.Time {
+		date, _ := time.Parse("2006-01-02", value)
+		return date
+	}
+
+	result := &entity.UserFilter{
+		Gender:        filter.Gender,
+		BirthDateFrom: parse(filter.BirthDateFrom),
+		BirthDateTo:   parse(filter.BirthDateTo),
+		AgeFrom:       filter.AgeFrom,
+		AgeTo:         filter.AgeTo,
+		CreatedFrom:   parse(filter.CreatedFrom),
+		DeletedState:  filter.DeletedState,
+		Name:          filter.Name,
+	}
+	if createdTo := parse(filter.CreatedTo); !createdTo.IsZero() {
+		result.CreatedTo = createdTo.AddDate(0, 0, 1)
+	}
+
+	return result
+}
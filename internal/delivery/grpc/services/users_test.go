@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/app"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+type UserRPCTestSuite struct {
+	suite.Suite
+	user *entity.User
+	rpc  pb.UserServiceServer
+}
+
+func (s *UserRPCTestSuite) SetupTest() {
+	s.user = &entity.User{
+		Id:          "6f1f9b8e-2d5c-4a53-9f0e-1c0b7c3f2a11",
+		PhoneNumber: "+998994767316",
+	}
+	s.rpc = NewUserRPC(zap.NewNop(), &userUsecaseStub{user: s.user}, serviceClientsStub{})
+}
+
+func (s *UserRPCTestSuite) as(id, role string) context.Context {
+	return app.ContextWithCaller(context.Background(), entity.Caller{Id: id, Role: role})
+}
+
+// test func
+func (s *UserRPCTestSuite) TestGet() {
+	var (
+		req    = &pb.GetUserReq{Field: "phone_number", Value: s.user.PhoneNumber}
+		denied *entity.ErrPermissionDenied
+	)
+
+	resp, err := s.rpc.Get(s.as(s.user.Id, entity.RoleUser), req)
+	s.Suite.NoError(err)
+	s.Suite.Equal(s.user.Id, resp.Id)
+
+	// users only read their own profile, staff read any
+	_, err = s.rpc.Get(s.as("0b7c3f2a-1c0b-4a53-9f0e-6f1f9b8e2d5c", entity.RoleUser), req)
+	s.Suite.ErrorAs(err, &denied)
+	_, err = s.rpc.Get(s.as("0b7c3f2a-1c0b-4a53-9f0e-6f1f9b8e2d5c", entity.RoleAdmin), req)
+	s.Suite.NoError(err)
+}
+
+func (s *UserRPCTestSuite) TestChangePassword() {
+	var (
+		req    = &pb.ChangeUserPasswordReq{PhoneNumber: s.user.PhoneNumber, Password: "newpassword"}
+		denied *entity.ErrPermissionDenied
+	)
+
+	resp, err := s.rpc.ChangePassword(s.as(s.user.Id, entity.RoleUser), req)
+	s.Suite.NoError(err)
+	s.Suite.True(resp.Status)
+
+	// the phone number of another account is refused to users
+	_, err = s.rpc.ChangePassword(s.as("0b7c3f2a-1c0b-4a53-9f0e-6f1f9b8e2d5c", entity.RoleUser), req)
+	s.Suite.ErrorAs(err, &denied)
+}
+
+func TestUserRPCTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRPCTestSuite))
+}
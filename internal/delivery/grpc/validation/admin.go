@@ -0,0 +1,130 @@
+package validation
+
+import (
+	pb "dennic_user_service/genproto/user_service"
+)
+
+func CreateAdmin(req *pb.Admin) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.role("role", req.Role)
+	v.name("first_name", req.FirstName)
+	v.name("last_name", req.LastName)
+	v.birthDate("birth_date", req.BirthDate)
+	v.phoneNumber("phone_number", req.PhoneNumber)
+	v.email("email", req.Email)
+	v.password("password", req.Password)
+	v.gender("gender", req.Gender)
+	v.workPeriod(req)
+	if req.RefreshToken != "" {
+		v.refreshToken("refresh_token", req.RefreshToken)
+	}
+	return v.err()
+}
+
+func UpdateAdmin(req *pb.Admin) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.name("first_name", req.FirstName)
+	v.name("last_name", req.LastName)
+	v.birthDate("birth_date", req.BirthDate)
+	v.gender("gender", req.Gender)
+	v.workPeriod(req)
+	return v.err()
+}
+
+func GetAdmin(req *pb.GetAdminReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	return v.err()
+}
+
+func ListAdmins(req *pb.ListAdminsReq) error {
+	v := newValidator()
+	if req.Value != "" {
+		v.required("field", req.Field)
+	}
+	v.pageToken("page_token", req.PageToken, req.Limit)
+	return v.err()
+}
+
+func DeleteAdmin(req *pb.DeleteAdminReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	return v.err()
+}
+
+func CheckAdminField(req *pb.CheckAdminFieldReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	return v.err()
+}
+
+func ChangeAdminPassword(req *pb.ChangeAdminPasswordReq) error {
+	v := newValidator()
+	v.check(req.Email != "" || req.PhoneNumber != "", "email", "email or phone_number is required")
+	if req.Email != "" {
+		v.email("email", req.Email)
+	}
+	if req.PhoneNumber != "" {
+		v.phoneNumber("phone_number", req.PhoneNumber)
+	}
+	v.password("password", req.Password)
+	return v.err()
+}
+
+func UpdateAdminRefreshToken(req *pb.UpdateRefreshTokenAdminReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.refreshToken("refresh_token", req.RefreshToken)
+	v.check(len(req.DeviceId) <= 100, "device_id", "must be at most 100 characters")
+	return v.err()
+}
+
+func RotateAdminRefreshToken(req *pb.RotateRefreshTokenAdminReq) error {
+	v := newValidator()
+	v.refreshToken("refresh_token", req.RefreshToken)
+	v.refreshToken("new_refresh_token", req.NewRefreshToken)
+	v.check(req.NewRefreshToken != req.RefreshToken, "new_refresh_token", "must differ from refresh_token")
+	return v.err()
+}
+
+func VerifyAdminCredentials(req *pb.VerifyAdminCredentialsReq) error {
+	v := newValidator()
+	v.check(req.Email != "" || req.PhoneNumber != "", "email", "email or phone_number is required")
+	v.required("password", req.Password)
+	return v.err()
+}
+
+func UnlockAdmin(req *pb.UnlockAdminReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	return v.err()
+}
+
+func RestoreAdmin(req *pb.RestoreAdminReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	return v.err()
+}
+
+func ChangeAdminRole(req *pb.ChangeAdminRoleReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.role("role", req.Role)
+	return v.err()
+}
+
+func (v *validator) workPeriod(req *pb.Admin) {
+	v.check(req.Salary >= 0, "salary", "must not be negative")
+	if !v.required("start_work_year", req.StartWorkYear) {
+		return
+	}
+	start, ok := v.date("start_work_year", req.StartWorkYear)
+	if !ok || req.EndWorkYear == "" {
+		return
+	}
+	if end, ok := v.date("end_work_year", req.EndWorkYear); ok {
+		v.check(!end.Before(start), "end_work_year", "must not be before start_work_year")
+	}
+}
@@ -0,0 +1,142 @@
+package validation
+
+import (
+	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/entity"
+	"unicode/utf8"
+)
+
+func CreateUser(req *pb.User) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.name("first_name", req.FirstName)
+	v.name("last_name", req.LastName)
+	v.birthDate("birth_date", req.BirthDate)
+	v.phoneNumber("phone_number", req.PhoneNumber)
+	v.password("password", req.Password)
+	v.gender("gender", req.Gender)
+	if req.RefreshToken != "" {
+		v.refreshToken("refresh_token", req.RefreshToken)
+	}
+	return v.err()
+}
+
+func UpdateUser(req *pb.User) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.name("first_name", req.FirstName)
+	v.name("last_name", req.LastName)
+	v.birthDate("birth_date", req.BirthDate)
+	v.gender("gender", req.Gender)
+	return v.err()
+}
+
+func GetUser(req *pb.GetUserReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	return v.err()
+}
+
+func ListUsers(req *pb.ListUsersReq) error {
+	v := newValidator()
+	if req.Value != "" {
+		v.required("field", req.Field)
+	}
+	if req.Filter != nil {
+		v.userFilter(req.Filter)
+	}
+	v.pageToken("page_token", req.PageToken, req.Limit)
+	return v.err()
+}
+
+func ExportUserData(req *pb.ExportUserDataReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	return v.err()
+}
+
+func SearchUsers(req *pb.SearchUsersReq) error {
+	v := newValidator()
+	if v.required("query", req.Query) {
+		v.check(utf8.RuneCountInString(req.Query) <= nameMaxLen*2, "query", "must be at most 100 characters")
+	}
+	v.check(req.Limit <= searchMaxLimit, "limit", "must be at most 100")
+	return v.err()
+}
+
+func DeleteUser(req *pb.DeleteUserReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	if req.Mode != "" {
+		v.check(deleteModes[req.Mode], "mode", "must be one of soft, hard, anonymize")
+	}
+	// anonymization is addressed by id only, so exactly one user is scrubbed
+	if req.Mode == entity.DeleteModeAnonymize {
+		v.check(req.Field == "id", "field", "must be id when mode is anonymize")
+		v.id("value", req.Value)
+	}
+	return v.err()
+}
+
+func CheckUserField(req *pb.CheckFieldUserReq) error {
+	v := newValidator()
+	v.fieldValue(req.Field, req.Value)
+	return v.err()
+}
+
+func ChangeUserPassword(req *pb.ChangeUserPasswordReq) error {
+	v := newValidator()
+	v.phoneNumber("phone_number", req.PhoneNumber)
+	v.password("password", req.Password)
+	return v.err()
+}
+
+func UpdateUserRefreshToken(req *pb.UpdateRefreshTokenUserReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	v.refreshToken("refresh_token", req.RefreshToken)
+	v.check(len(req.DeviceId) <= 100, "device_id", "must be at most 100 characters")
+	return v.err()
+}
+
+func RotateUserRefreshToken(req *pb.RotateRefreshTokenUserReq) error {
+	v := newValidator()
+	v.refreshToken("refresh_token", req.RefreshToken)
+	v.refreshToken("new_refresh_token", req.NewRefreshToken)
+	v.check(req.NewRefreshToken != req.RefreshToken, "new_refresh_token", "must differ from refresh_token")
+	return v.err()
+}
+
+func VerifyUserCredentials(req *pb.VerifyUserCredentialsReq) error {
+	v := newValidator()
+	v.required("phone_number", req.PhoneNumber)
+	v.required("password", req.Password)
+	return v.err()
+}
+
+func UnlockUser(req *pb.UnlockUserReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	return v.err()
+}
+
+func RestoreUser(req *pb.RestoreUserReq) error {
+	v := newValidator()
+	v.id("id", req.Id)
+	return v.err()
+}
+
+func (v *validator) userFilter(filter *pb.UserFilter) {
+	if filter.Gender != "" {
+		v.gender("filter.gender", filter.Gender)
+	}
+	v.dateRange("filter.birth_date_from", filter.BirthDateFrom, "filter.birth_date_to", filter.BirthDateTo)
+	v.dateRange("filter.created_from", filter.CreatedFrom, "filter.created_to", filter.CreatedTo)
+	if filter.AgeFrom > 0 && filter.AgeTo > 0 {
+		v.check(filter.AgeFrom <= filter.AgeTo, "filter.age_to", "must not be less than filter.age_from")
+	}
+	if filter.DeletedState != "" {
+		v.check(deletedStates[filter.DeletedState], "filter.deleted_state", "must be one of active, deleted, all")
+	}
+	v.check(utf8.RuneCountInString(filter.Name) <= nameMaxLen, "filter.name", "must be at most 50 characters")
+}
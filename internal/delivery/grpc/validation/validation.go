@@ -0,0 +1,175 @@
+// Package validation checks user_service requests before they reach the usecases.
+//
+// Every function returns nil or an *entity.ErrValidation whose Errors hold one
+// message per invalid field, which ErrorStatus turns into a BadRequest.
+package validation
+
+import (
+	"dennic_user_service/internal/entity"
+	"errors"
+	"net/mail"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	nameMaxLen         = 50
+	emailMaxLen        = 100
+	passwordMinLen     = 8
+	passwordMaxLen     = 128
+	refreshTokenMaxLen = 1024
+	pageTokenMaxLen    = 1024
+	searchMaxLimit     = 100
+)
+
+var (
+	errInvalidRequest = errors.New("request has invalid fields")
+
+	phoneNumberRegexp = regexp.MustCompile(`^\+?[0-9]{9,15}$`)
+
+	genders = map[string]bool{"male": true, "female": true}
+	roles   = map[string]bool{entity.RoleAdmin: true, entity.RoleSuperadmin: true}
+
+	deletedStates = map[string]bool{
+		entity.DeletedStateActive:  true,
+		entity.DeletedStateDeleted: true,
+		entity.DeletedStateAll:     true,
+	}
+	deleteModes = map[string]bool{
+		entity.DeleteModeSoft:      true,
+		entity.DeleteModeHard:      true,
+		entity.DeleteModeAnonymize: true,
+	}
+)
+
+type validator struct {
+	errs *entity.ErrValidation
+}
+
+func newValidator() *validator {
+	return &validator{errs: entity.NewErrValidation()}
+}
+
+// check records msg for field when ok is false, only the first violation of a field is kept
+func (v *validator) check(ok bool, field, msg string) {
+	if ok {
+		return
+	}
+	if _, exists := v.errs.Errors[field]; !exists {
+		v.errs.Errors[field] = msg
+	}
+}
+
+func (v *validator) required(field, value string) bool {
+	v.check(value != "", field, "is required")
+	return value != ""
+}
+
+func (v *validator) id(field, value string) {
+	if v.required(field, value) {
+		_, err := uuid.Parse(value)
+		v.check(err == nil, field, "must be a valid uuid")
+	}
+}
+
+func (v *validator) name(field, value string) {
+	if v.required(field, value) {
+		v.check(utf8.RuneCountInString(value) <= nameMaxLen, field, "must be at most 50 characters")
+	}
+}
+
+func (v *validator) date(field, value string) (time.Time, bool) {
+	date, err := time.Parse(dateLayout, value)
+	v.check(err == nil, field, "must be a date in YYYY-MM-DD format")
+	return date, err == nil
+}
+
+func (v *validator) birthDate(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if date, ok := v.date(field, value); ok {
+		v.check(date.Before(time.Now()), field, "must be in the past")
+	}
+}
+
+func (v *validator) gender(field, value string) {
+	if v.required(field, value) {
+		v.check(genders[value], field, "must be one of male, female")
+	}
+}
+
+func (v *validator) phoneNumber(field, value string) {
+	if v.required(field, value) {
+		v.check(phoneNumberRegexp.MatchString(value), field, "must contain 9 to 15 digits with an optional leading +")
+	}
+}
+
+func (v *validator) email(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	address, err := mail.ParseAddress(value)
+	v.check(err == nil && address.Address == value && len(value) <= emailMaxLen, field, "must be a valid email address")
+}
+
+func (v *validator) password(field, value string) {
+	if v.required(field, value) {
+		length := utf8.RuneCountInString(value)
+		v.check(length >= passwordMinLen && length <= passwordMaxLen, field, "must be between 8 and 128 characters")
+	}
+}
+
+func (v *validator) refreshToken(field, value string) {
+	if v.required(field, value) {
+		v.check(len(value) <= refreshTokenMaxLen, field, "must be at most 1024 characters")
+	}
+}
+
+func (v *validator) role(field, value string) {
+	if v.required(field, value) {
+		v.check(roles[value], field, "must be one of admin, superadmin")
+	}
+}
+
+// pageToken checks an optional page token, which only makes sense with a page size
+func (v *validator) pageToken(field, value string, limit uint64) {
+	if value == "" {
+		return
+	}
+	v.check(len(value) <= pageTokenMaxLen, field, "must be at most 1024 characters")
+	v.check(limit >= 1, "limit", "is required with "+field)
+}
+
+// dateRange checks optional from and to dates and that they are in order
+func (v *validator) dateRange(fromField, from, toField, to string) {
+	var fromDate, toDate time.Time
+	fromOk, toOk := false, false
+	if from != "" {
+		fromDate, fromOk = v.date(fromField, from)
+	}
+	if to != "" {
+		toDate, toOk = v.date(toField, to)
+	}
+	if fromOk && toOk {
+		v.check(!toDate.Before(fromDate), toField, "must not be before "+fromField)
+	}
+}
+
+func (v *validator) fieldValue(field, value string) {
+	v.required("field", field)
+	v.required("value", value)
+}
+
+func (v *validator) err() error {
+	if len(v.errs.Errors) == 0 {
+		return nil
+	}
+	v.errs.Err = errInvalidRequest
+	return v.errs
+}
@@ -0,0 +1,137 @@
+package validation
+
+import (
+	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/entity"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ValidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *ValidationTestSuite) violations(err error) map[string]string {
+	var errValidation *entity.ErrValidation
+	s.Suite.ErrorAs(err, &errValidation)
+	s.Suite.NotEmpty(errValidation.Error())
+	return errValidation.Errors
+}
+
+func (s *ValidationTestSuite) TestCreateUser() {
+	user := &pb.User{
+		Id:          "123e4567-e89b-12d3-a456-426614174001",
+		FirstName:   "John",
+		LastName:    "Doe",
+		BirthDate:   "1990-05-15",
+		PhoneNumber: "+998994767316",
+		Password:    "password123",
+		Gender:      "male",
+	}
+	s.Suite.NoError(CreateUser(user))
+
+	errs := s.violations(CreateUser(&pb.User{
+		Id:          "not-a-uuid",
+		LastName:    "Doe",
+		BirthDate:   "15.05.1990",
+		PhoneNumber: "phone",
+		Password:    "short",
+		Gender:      "other",
+	}))
+	s.Suite.Equal(map[string]string{
+		"id":           "must be a valid uuid",
+		"first_name":   "is required",
+		"birth_date":   "must be a date in YYYY-MM-DD format",
+		"phone_number": "must contain 9 to 15 digits with an optional leading +",
+		"password":     "must be between 8 and 128 characters",
+		"gender":       "must be one of male, female",
+	}, errs)
+}
+
+func (s *ValidationTestSuite) TestCreateAdmin() {
+	admin := &pb.Admin{
+		Id:            "123e4567-e89b-12d3-a456-426614174001",
+		Role:          "admin",
+		FirstName:     "Jane",
+		LastName:      "Doe",
+		BirthDate:     "1992-08-20",
+		PhoneNumber:   "998994767316",
+		Email:         "jane@dennic.uz",
+		Password:      "password456",
+		Gender:        "female",
+		StartWorkYear: "2020-01-01",
+	}
+	s.Suite.NoError(CreateAdmin(admin))
+
+	admin.Role = "owner"
+	admin.Email = "jane"
+	admin.Salary = -1
+	admin.EndWorkYear = "2019-01-01"
+	errs := s.violations(CreateAdmin(admin))
+	s.Suite.Contains(errs, "role")
+	s.Suite.Contains(errs, "email")
+	s.Suite.Contains(errs, "salary")
+	s.Suite.Contains(errs, "end_work_year")
+	s.Suite.Len(errs, 4)
+}
+
+func (s *ValidationTestSuite) TestRequests() {
+	s.Suite.Contains(s.violations(GetUser(&pb.GetUserReq{Field: "id"})), "value")
+	s.Suite.NoError(ListUsers(&pb.ListUsersReq{Page: 1, Limit: 10}))
+	s.Suite.Contains(s.violations(ListAdmins(&pb.ListAdminsReq{PageToken: "token"})), "limit")
+	s.Suite.NoError(SearchUsers(&pb.SearchUsersReq{Query: "Шохрух", Limit: 10}))
+	s.Suite.Contains(s.violations(SearchUsers(&pb.SearchUsersReq{Limit: 500})), "query")
+	s.Suite.Contains(s.violations(SearchUsers(&pb.SearchUsersReq{Query: "ali", Limit: 500})), "limit")
+	s.Suite.Contains(s.violations(ChangeAdminPassword(&pb.ChangeAdminPasswordReq{Password: "password456"})), "email")
+	s.Suite.Contains(s.violations(RotateUserRefreshToken(&pb.RotateRefreshTokenUserReq{
+		RefreshToken:    "token",
+		NewRefreshToken: "token",
+	})), "new_refresh_token")
+	s.Suite.Contains(s.violations(ChangeAdminRole(&pb.ChangeAdminRoleReq{
+		Id:   "123e4567-e89b-12d3-a456-426614174001",
+		Role: "user",
+	})), "role")
+}
+
+func (s *ValidationTestSuite) TestDeleteUser() {
+	s.Suite.NoError(DeleteUser(&pb.DeleteUserReq{Field: "phone_number", Value: "+998994767316"}))
+	s.Suite.NoError(DeleteUser(&pb.DeleteUserReq{Field: "id", Value: "123e4567-e89b-12d3-a456-426614174001", Mode: "anonymize"}))
+	s.Suite.Contains(s.violations(DeleteUser(&pb.DeleteUserReq{Field: "id", Value: "id", Mode: "archive"})), "mode")
+
+	violations := s.violations(DeleteUser(&pb.DeleteUserReq{Field: "phone_number", Value: "+998994767316", Mode: "anonymize"}))
+	s.Suite.Equal(map[string]string{
+		"field": "must be id when mode is anonymize",
+		"value": "must be a valid uuid",
+	}, violations)
+}
+
+func (s *ValidationTestSuite) TestUserFilter() {
+	s.Suite.NoError(ListUsers(&pb.ListUsersReq{Filter: &pb.UserFilter{
+		Gender:        "female",
+		BirthDateFrom: "1990-01-01",
+		BirthDateTo:   "2000-12-31",
+		AgeFrom:       20,
+		AgeTo:         30,
+		DeletedState:  "all",
+		Name:          "Ali",
+	}}))
+
+	violations := s.violations(ListUsers(&pb.ListUsersReq{Filter: &pb.UserFilter{
+		Gender:       "other",
+		CreatedFrom:  "2024-02-01",
+		CreatedTo:    "2024-01-01",
+		AgeFrom:      40,
+		AgeTo:        30,
+		DeletedState: "archived",
+	}}))
+	s.Suite.Contains(violations, "filter.gender")
+	s.Suite.Contains(violations, "filter.created_to")
+	s.Suite.Contains(violations, "filter.age_to")
+	s.Suite.Contains(violations, "filter.deleted_state")
+	s.Suite.NotContains(violations, "filter.created_from")
+}
+
+func TestValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidationTestSuite))
+}
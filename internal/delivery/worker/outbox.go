@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"dennic_user_service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// OutboxWorker periodically relays the outbox to the broker
+type OutboxWorker struct {
+	logger   *zap.Logger
+	outbox   usecase.OutboxStorageI
+	interval time.Duration
+}
+
+func NewOutboxWorker(logger *zap.Logger, outbox usecase.OutboxStorageI, interval time.Duration) *OutboxWorker {
+	return &OutboxWorker{
+		logger:   logger,
+		outbox:   outbox,
+		interval: interval,
+	}
+}
+
+// Run relays once right away and then every interval until stop is closed. The outbox is relayed
+// once more on stop, so events of requests finished during shutdown are not left behind, unless
+// ctx is done first.
+func (w *OutboxWorker) Run(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-stop:
+			w.runOnce(ctx)
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OutboxWorker) runOnce(ctx context.Context) {
+	resp, err := w.outbox.Relay(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("relay outbox error", zap.Error(err))
+		}
+		return
+	}
+	if resp.Failed > 0 {
+		w.logger.Warn("outbox events postponed after failed delivery",
+			zap.Int64("published", resp.Published),
+			zap.Int64("failed", resp.Failed),
+		)
+	}
+}
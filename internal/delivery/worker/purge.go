@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"dennic_user_service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeWorker periodically purges soft deleted accounts past their retention
+type PurgeWorker struct {
+	logger   *zap.Logger
+	purge    usecase.PurgeStorageI
+	interval time.Duration
+}
+
+func NewPurgeWorker(logger *zap.Logger, purge usecase.PurgeStorageI, interval time.Duration) *PurgeWorker {
+	return &PurgeWorker{
+		logger:   logger,
+		purge:    purge,
+		interval: interval,
+	}
+}
+
+// Run purges once right away and then every interval until stop is closed, a purge in progress
+// is finished unless ctx is done first
+func (w *PurgeWorker) Run(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runOnce(ctx)
+
+		select {
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *PurgeWorker) runOnce(ctx context.Context) {
+	resp, err := w.purge.Purge(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("purge soft deleted accounts error", zap.Error(err))
+		}
+		return
+	}
+	if resp.Skipped {
+		w.logger.Debug("purge skipped, another replica holds the purge lock")
+	}
+	if resp.Users > 0 || resp.Admins > 0 {
+		w.logger.Info("purged soft deleted accounts",
+			zap.Int64("users", resp.Users),
+			zap.Int64("admins", resp.Admins),
+		)
+	}
+}
@@ -6,8 +6,13 @@ import (
 )
 
 var (
-	ErrorConflict = NewErrConflict("object")
-	ErrorNotFound = NewErrNotFound("object")
+	ErrorConflict         = NewErrConflict("object")
+	ErrorNotFound         = NewErrNotFound("object")
+	ErrorPermissionDenied = NewErrPermissionDenied("action")
+	ErrorLastSuperadmin   = NewErrFailedPrecondition("the last active superadmin cannot be removed or demoted")
+	ErrorPhoneNumberTaken = NewErrConflict("active account with this phone number")
+	ErrorMessageReused    = NewErrConflict("processed message with this id and another payload")
+	ErrorAmbiguousAdmin   = NewErrFailedPrecondition("the email and phone number belong to different admins")
 )
 
 // error not found
@@ -36,6 +41,32 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error permission denied
+type ErrPermissionDenied struct {
+	action string
+}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "permission denied for " + e.action
+}
+
+func NewErrPermissionDenied(action string) *ErrPermissionDenied {
+	return &ErrPermissionDenied{action}
+}
+
+// error failed precondition
+type ErrFailedPrecondition struct {
+	reason string
+}
+
+func (e *ErrFailedPrecondition) Error() string {
+	return e.reason
+}
+
+func NewErrFailedPrecondition(reason string) *ErrFailedPrecondition {
+	return &ErrFailedPrecondition{reason}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
@@ -58,6 +89,10 @@ func NewErrNoRequiredParameter(parameters ...string) *ErrNoRequiredParameter {
 	return &ErrNoRequiredParameter{parameters: parameters}
 }
 
+func (e ErrNoRequiredParameter) Parameters() []string {
+	return e.parameters
+}
+
 func (e ErrNoRequiredParameter) Error() string {
 	var str strings.Builder
 	for _, param := range e.parameters {
@@ -2,6 +2,51 @@ package entity
 
 import "time"
 
+const (
+	RoleUser       = "user"
+	RoleAdmin      = "admin"
+	RoleSuperadmin = "superadmin"
+
+	AccountTypeUser  = "user"
+	AccountTypeAdmin = "admin"
+
+	AccountStatusActive = "active"
+	AccountStatusLocked = "locked"
+
+	// account lifecycle events, every event type is published to the topic of the same name
+	EventUserCreated          = "user.created"
+	EventUserUpdated          = "user.updated"
+	EventUserDeleted          = "user.deleted"
+	EventUserPasswordChanged  = "user.password_changed"
+	EventUserRestored         = "user.restored"
+	EventUserAnonymized       = "user.anonymized"
+	EventAdminCreated         = "admin.created"
+	EventAdminUpdated         = "admin.updated"
+	EventAdminDeleted         = "admin.deleted"
+	EventAdminPasswordChanged = "admin.password_changed"
+	EventAdminRestored        = "admin.restored"
+
+	// EventSchemaVersion is raised whenever the envelope or a payload changes incompatibly
+	EventSchemaVersion = 1
+
+	PurgeModeDelete    = "delete"
+	PurgeModeAnonymize = "anonymize"
+
+	DeleteModeSoft      = "soft"
+	DeleteModeHard      = "hard"
+	DeleteModeAnonymize = "anonymize"
+
+	DeletedStateActive  = "active"
+	DeletedStateDeleted = "deleted"
+	DeletedStateAll     = "all"
+)
+
+// Caller is the authenticated account on whose behalf a request is made
+type Caller struct {
+	Id   string
+	Role string
+}
+
 type User struct {
 	Id           string
 	UserOrder    uint64
@@ -14,9 +59,13 @@ type User struct {
 	RefreshToken string
 	ImageUrl     string
 	Count        int64
+	Cursor       string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    time.Time
+
+	FailedLoginAttempts int64
+	LockedUntil         time.Time
 }
 
 type Admin struct {
@@ -38,9 +87,13 @@ type Admin struct {
 	RefreshToken  string
 	ImageUrl      string
 	Count         int64
+	Cursor        string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     time.Time
+
+	FailedLoginAttempts int64
+	LockedUntil         time.Time
 }
 
 type GetAllReq struct {
@@ -50,6 +103,31 @@ type GetAllReq struct {
 	Field        string
 	Value        string
 	OrderBy      string
+	IsLocked     bool
+	PageToken    string
+	Filter       *UserFilter
+}
+
+// UserFilter narrows a user list, zero fields are not applied.
+// DeletedState overrides DeleteStatus when set.
+type UserFilter struct {
+	Gender        string
+	BirthDateFrom time.Time
+	BirthDateTo   time.Time
+	AgeFrom       uint32
+	AgeTo         uint32
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	DeletedState  string
+	Name          string
+}
+
+// SearchUsersReq is a fuzzy search over user names and phone numbers.
+// Terms holds the spellings of Query to match and is filled by the usecase.
+type SearchUsersReq struct {
+	Query string
+	Terms []string
+	Limit uint64
 }
 
 type FieldValueReq struct {
@@ -67,8 +145,10 @@ type CheckFieldResp struct {
 	Status bool
 }
 
+// CheckDeleteResp lists the ids of the deleted accounts, a field other than id may match several
 type CheckDeleteResp struct {
 	Status bool
+	Ids    []string
 }
 
 type IfExistsReq struct {
@@ -94,19 +174,254 @@ type ChangeAdminPasswordReq struct {
 	Password    string
 }
 
+// RehashPasswordReq replaces the stored hash of the account with Id after a successful login
+type RehashPasswordReq struct {
+	Id       string
+	Password string
+}
+
 type ChangePasswordResp struct {
 	Status bool
+	Id     string
 }
 
 type ChangeAdminPasswordResp struct {
 	Status bool
+	Id     string
 }
 
 type UpdateRefreshTokenReq struct {
 	Id           string
 	RefreshToken string
+	DeviceId     string
 }
 
 type UpdateRefreshTokenResp struct {
 	Status bool
 }
+
+type RotateRefreshTokenReq struct {
+	RefreshToken    string
+	NewRefreshToken string
+}
+
+type RotateRefreshTokenResp struct {
+	Status        bool
+	AccountId     string
+	ReuseDetected bool
+}
+
+type RefreshToken struct {
+	Id          string
+	AccountId   string
+	AccountType string
+	FamilyId    string
+	TokenHash   string
+	DeviceId    string
+	ParentId    string
+	RotatedAt   time.Time
+	RevokedAt   time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+type ChangeAdminRoleReq struct {
+	Id        string
+	Role      string
+	UpdatedAt time.Time
+}
+
+type ChangeAdminRoleResp struct {
+	Status bool
+}
+
+// SuperadminCount reports how many active superadmins exist and how many of them match a filter
+type SuperadminCount struct {
+	Total   int64
+	Matched int64
+}
+
+type VerifyCredentialsReq struct {
+	PhoneNumber string
+	Email       string
+	Password    string
+}
+
+type VerifyCredentialsResp struct {
+	Valid       bool
+	Id          string
+	Role        string
+	Status      string
+	LockedUntil time.Time
+}
+
+type Credentials struct {
+	Id             string
+	Role           string
+	PhoneNumber    string
+	Email          string
+	Password       string
+	PasswordRehash bool
+
+	FailedLoginAttempts int64
+	LockedUntil         time.Time
+}
+
+type LoginAttempt struct {
+	AccountType string
+	AccountId   string
+	Identifier  string
+	Success     bool
+	CreatedAt   time.Time
+}
+
+type CountLoginAttemptsReq struct {
+	AccountType string
+	Identifier  string
+	Since       time.Time
+}
+
+// ClearLoginAttemptsReq selects the failed attempts counted against the identifiers of an account
+type ClearLoginAttemptsReq struct {
+	AccountType string
+	Identifiers []string
+}
+
+// AccountReq selects the records of one account in tables shared by users and admins
+type AccountReq struct {
+	AccountType string
+	AccountId   string
+}
+
+type ExportUserDataReq struct {
+	Id string
+}
+
+// UserDataExport is everything this service stores about a user
+type UserDataExport struct {
+	User          *User
+	LoginAttempts []*LoginAttempt
+	Sessions      []*RefreshToken
+}
+
+type LockAccountReq struct {
+	Id          string
+	LockedUntil time.Time
+}
+
+type RestoreAccountReq struct {
+	Id        string
+	UpdatedAt time.Time
+}
+
+type RestoreAccountResp struct {
+	Status bool
+}
+
+// PurgeBatchReq selects up to Limit accounts soft deleted before DeletedBefore
+type PurgeBatchReq struct {
+	AccountType   string
+	DeletedBefore time.Time
+	Limit         uint64
+	Mode          string
+	PurgedAt      time.Time
+}
+
+// PurgedAccount is an account a purge batch removed or anonymized, ImageUrl is the image it had
+type PurgedAccount struct {
+	Id       string
+	Order    uint64
+	ImageUrl string
+}
+
+// PurgeResp counts the accounts one purge run removed or anonymized.
+// Skipped is set when another replica held the purge lock.
+type PurgeResp struct {
+	Users   int64
+	Admins  int64
+	Skipped bool
+}
+
+// AnonymizeAccountReq scrubs the personal data of an account, the id and order number are kept
+type AnonymizeAccountReq struct {
+	Id           string
+	AnonymizedAt time.Time
+}
+
+// AnonymizeAccountResp carries the image the account had before it was scrubbed,
+// Status is false when there was no account left to anonymize
+type AnonymizeAccountResp struct {
+	Status    bool
+	UserOrder uint64
+	ImageUrl  string
+}
+
+// Event announces a change of an account to other services through the broker,
+// AggregateId is the id of the account and keys the message. Id stays the same
+// across redeliveries of the event.
+type Event struct {
+	Id          string
+	Type        string
+	AggregateId string
+	OccurredAt  time.Time
+	Payload     any
+	// TraceContext carries the trace of the change the event announces, so its publication joins it
+	TraceContext map[string]string
+}
+
+// OutboxMessage is an event waiting in the outbox, Payload holds the JSON written with the event
+type OutboxMessage struct {
+	Event
+	Attempts int
+}
+
+// PendingOutboxReq selects up to Limit events due at Now, in the order they were written
+type PendingOutboxReq struct {
+	Now   time.Time
+	Limit uint64
+}
+
+// ClaimOutboxReq keeps other relays away from the events with Ids until Until
+type ClaimOutboxReq struct {
+	Ids   []string
+	Until time.Time
+}
+
+// OutboxFailureReq postpones an event that could not be published until NextAttemptAt
+type OutboxFailureReq struct {
+	Id            string
+	NextAttemptAt time.Time
+	Error         string
+}
+
+// RelayResp counts the events one relay run published and postponed.
+// Skipped is set when another replica held the relay lock.
+type RelayResp struct {
+	Published int64
+	Failed    int64
+	Skipped   bool
+}
+
+// ProcessedMessage records a consumed message, Id is unique per Consumer and PayloadHash
+// tells a redelivery apart from a different message reusing the id
+type ProcessedMessage struct {
+	Consumer    string
+	Id          string
+	PayloadHash string
+	ProcessedAt time.Time
+}
+
+// ClaimMessageResp reports whether the message was claimed now, otherwise PayloadHash is the
+// hash it was first processed with
+type ClaimMessageResp struct {
+	Claimed     bool
+	PayloadHash string
+}
+
+type UnlockAccountReq struct {
+	Id string
+}
+
+type UnlockAccountResp struct {
+	Status bool
+}
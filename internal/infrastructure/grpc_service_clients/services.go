@@ -1,26 +1,46 @@
 package grpc_service_clients
 
 import (
+	"dennic_user_service/genproto/booking_service"
 	"dennic_user_service/internal/pkg/config"
+	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
 	// SmsService()
+	PatientsService() booking_service.PatientsServiceClient
 	Close()
 }
 
 type serviceClients struct {
-	services []*grpc.ClientConn
+	patientsService booking_service.PatientsServiceClient
+	services        []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
+	// booking service, the connection is established lazily on the first call
+	connBookingService, err := grpc.Dial(
+		fmt.Sprintf("%s%s", config.BookingService.Host, config.BookingService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("booking service dial host: %s port: %s : %w",
+			config.BookingService.Host, config.BookingService.Port, err)
+	}
+
 	return &serviceClients{
-		services: []*grpc.ClientConn{},
+		patientsService: booking_service.NewPatientsServiceClient(connBookingService),
+		services:        []*grpc.ClientConn{connBookingService},
 	}, nil
 }
 
+func (s *serviceClients) PatientsService() booking_service.PatientsServiceClient {
+	return s.patientsService
+}
+
 func (s *serviceClients) Close() {
 	// closing investment service
 	for _, conn := range s.services {
@@ -2,9 +2,20 @@ package kafka
 
 import (
 	"context"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/usecase/event"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -18,13 +29,27 @@ type HandlerFunc func(ctx context.Context, key, value []byte) error
 type consumer struct {
 	logger          *zap.Logger
 	consumerConfigs []event.ConsumerConfig
-	readers         []*kafka.Reader
+	delivery        *event.Delivery
+	deadLetter      *kafka.Writer
 }
 
-func NewConsumer(logger *zap.Logger) *consumer {
-	return &consumer{
-		logger: logger,
+func NewConsumer(config *config.Config, logger *zap.Logger) (*consumer, error) {
+	delivery, err := event.NewDelivery(config, logger)
+	if err != nil {
+		return nil, err
 	}
+
+	return &consumer{
+		logger:   logger,
+		delivery: delivery,
+		// every dead letter message names the topic derived from its original topic
+		deadLetter: &kafka.Writer{
+			Addr:                   kafka.TCP(config.Kafka.Address...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}, nil
 }
 
 func (c *consumer) RegisterConsumer(consumerConfig event.ConsumerConfig) {
@@ -33,47 +58,166 @@ func (c *consumer) RegisterConsumer(consumerConfig event.ConsumerConfig) {
 
 func (c *consumer) Run() {
 	for _, consumerConfig := range c.consumerConfigs {
-		r := kafka.NewReader(kafka.ReaderConfig{
-			Brokers:  consumerConfig.GetBrokers(),
-			Topic:    consumerConfig.GetTopic(),
-			GroupID:  consumerConfig.GetGroupID(),
-			MinBytes: MinBytes,
-			MaxBytes: MaxBytes,
+		consumerConfig := consumerConfig
+		c.delivery.Go(func() {
+			c.runReader(consumerConfig)
 		})
-		c.readers = append(c.readers, r)
-		go runReader(r, consumerConfig, c.logger)
 	}
 }
 
-func (c *consumer) Close() {
-	for _, reader := range c.readers {
-		if err := reader.Close(); err != nil {
-			c.logger.Error("consumer reader close", zap.Error(err))
-		}
+// Close stops every reader once its current message is handled and committed, a message waiting
+// for a retry is left uncommitted. Messages still in progress when ctx is done are cancelled.
+func (c *consumer) Close(ctx context.Context) error {
+	err := c.delivery.Close(ctx)
+	if closeErr := c.deadLetter.Close(); closeErr != nil {
+		c.logger.Error("error during close writer dead letter", zap.Error(closeErr))
 	}
+	return err
 }
 
-func runReader(r *kafka.Reader, consumerConfig event.ConsumerConfig, logger *zap.Logger) {
+func newReader(consumerConfig event.ConsumerConfig) *kafka.Reader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  consumerConfig.GetBrokers(),
+		Topic:    consumerConfig.GetTopic(),
+		GroupID:  consumerConfig.GetGroupID(),
+		MinBytes: MinBytes,
+		MaxBytes: MaxBytes,
+	})
+}
+
+// runReader consumes the topic until the consumer is closed. A reader failing to fetch is
+// closed and replaced by a new one after a backoff, so a broker outage does not end consumption.
+func (c *consumer) runReader(consumerConfig event.ConsumerConfig) {
 	var (
-		topic   = consumerConfig.GetTopic()
-		handler = consumerConfig.GetHandler()
+		topic    = consumerConfig.GetTopic()
+		restarts int
 	)
 	for {
-		ctx := context.Background()
-		m, err := r.FetchMessage(ctx)
-		if err != nil {
-			logger.Error("consumer failed to fetch message:", zap.String("topic", topic), zap.Error(err))
-			break
+		r := newReader(consumerConfig)
+		fetched, err := c.consume(r, consumerConfig)
+		if closeErr := r.Close(); closeErr != nil {
+			c.logger.Error("consumer reader close", zap.String("topic", topic), zap.Error(closeErr))
+		}
+		if c.delivery.Stopping().Err() != nil {
+			return
+		}
+		// a reader that got messages was healthy, its failure starts a new backoff sequence
+		if fetched > 0 {
+			restarts = 0
 		}
 
-		if err := handler(ctx, m.Key, m.Value); err != nil {
-			logger.Error("consumer failed to handler message:", zap.ByteString("value", m.Value), zap.String("topic", topic), zap.Error(err))
-			continue
+		c.logger.Error("consumer failed to fetch message, restarting reader",
+			zap.String("topic", topic),
+			zap.Int("restarts", restarts),
+			zap.Error(err),
+		)
+		if !c.delivery.Wait(restarts) {
+			return
+		}
+		restarts++
+	}
+}
+
+// messageKey routes messages with the same key, or without a key from the same partition, to the same worker
+func messageKey(m kafka.Message) []byte {
+	if len(m.Key) > 0 {
+		return m.Key
+	}
+	return []byte(strconv.Itoa(m.Partition))
+}
+
+// consume handles messages of the reader until fetching fails or the consumer is closed, it
+// reports how many messages were fetched. Messages are handled by the worker pool, a partition
+// is committed only up to the messages handled without a gap, so a restart never skips one.
+func (c *consumer) consume(r *kafka.Reader, consumerConfig event.ConsumerConfig) (int, error) {
+	var (
+		topic    = consumerConfig.GetTopic()
+		tracker  = event.NewOffsetTracker()
+		commitMu sync.Mutex
+	)
+	pool := event.NewKeyedPool(c.delivery.Workers(), messageKey, func(m kafka.Message) {
+		// queued messages are left uncommitted once the consumer is closed
+		if c.delivery.Stopping().Err() != nil || !c.handle(m, consumerConfig) {
+			return
 		}
 
-		if err := r.CommitMessages(ctx, m); err != nil {
-			logger.Error("consumer failed to commit messages:", zap.String("topic", topic), zap.Error(err))
+		// commits are sent one at a time so a partition offset never moves back
+		commitMu.Lock()
+		defer commitMu.Unlock()
+		offset, ok := tracker.Finish(m.Partition, m.Offset)
+		if !ok {
+			return
 		}
+		commit := kafka.Message{Topic: topic, Partition: m.Partition, Offset: offset}
+		if err := r.CommitMessages(c.delivery.Handling(), commit); err != nil {
+			c.logger.Error("consumer failed to commit messages:", zap.String("topic", topic), zap.Error(err))
+		}
+	})
+	defer pool.Close()
+
+	for fetched := 0; ; fetched++ {
+		m, err := r.FetchMessage(c.delivery.Stopping())
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return fetched, fmt.Errorf("reader closed: %w", err)
+			}
+			return fetched, err
+		}
+
+		tracker.Start(m.Partition, m.Offset)
+		pool.Submit(m)
+	}
+}
+
+// handle settles the message through the shared delivery, a message that keeps failing is
+// written to the dead letter topic next to its original headers
+func (c *consumer) handle(m kafka.Message, consumerConfig event.ConsumerConfig) bool {
+	ctx, span := c.startSpan(m, consumerConfig)
+	defer span.End()
+
+	return c.delivery.Handle(ctx, span, &event.ConsumedMessage{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Group:     consumerConfig.GetGroupID(),
+		Key:       m.Key,
+		Value:     m.Value,
+	}, consumerConfig.GetHandler(), func(ctx context.Context, deadLetter *event.DeadLetter) error {
+		return c.deadLetter.WriteMessages(ctx, deadLetterMessage(m, deadLetter))
+	})
+}
+
+// startSpan starts the span handling the message, it continues the trace found in the message
+// headers and links the producer span
+func (c *consumer) startSpan(m kafka.Message, consumerConfig event.ConsumerConfig) (context.Context, otlp.Span) {
+	ctx := otel.GetTextMapPropagator().Extract(c.delivery.Handling(), headerCarrier{headers: &m.Headers})
+	return otlp.Start(ctx, consumerServiceName, m.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationKey.String(consumerConfig.GetTopic()),
+			semconv.MessagingOperationProcess,
+			semconv.MessagingKafkaConsumerGroupKey.String(consumerConfig.GetGroupID()),
+			semconv.MessagingKafkaPartitionKey.Int(m.Partition),
+			semconv.MessagingKafkaMessageKeyKey.String(string(m.Key)),
+			attribute.Int64("messaging.kafka.offset", m.Offset),
+		),
+	)
+}
+
+func deadLetterMessage(m kafka.Message, deadLetter *event.DeadLetter) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers)+len(deadLetter.Headers))
+	headers = append(headers, m.Headers...)
+	for _, header := range deadLetter.Headers {
+		headers = append(headers, kafka.Header{Key: header.Key, Value: []byte(header.Value)})
+	}
+
+	return kafka.Message{
+		Topic:   deadLetter.Topic,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
 	}
 }
 
@@ -112,4 +256,4 @@ func (c *ConsumerConfig) GetGroupID() string {
 
 func (c *ConsumerConfig) GetHandler() func(ctx context.Context, key, value []byte) error {
 	return c.handler
-}
\ No newline at end of file
+}
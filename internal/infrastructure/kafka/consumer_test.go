@@ -0,0 +1,88 @@
+package kafka
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/usecase/event"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type ConsumerTestSuite struct {
+	suite.Suite
+	consumer *consumer
+}
+
+func (s *ConsumerTestSuite) SetupTest() {
+	cfg := config.New()
+	cfg.Kafka.Consumer.MaxRetries = "2"
+	cfg.Kafka.Consumer.BackoffBase = "1ms"
+	cfg.Kafka.Consumer.BackoffMax = "5ms"
+
+	var err error
+	s.consumer, err = NewConsumer(cfg, zap.NewNop())
+	s.Suite.NoError(err)
+}
+
+func (s *ConsumerTestSuite) TearDownTest() {
+	s.Suite.NoError(s.consumer.Close(context.Background()))
+}
+
+func (s *ConsumerTestSuite) TestDeadLetterMessage() {
+	message := deadLetterMessage(kafka.Message{
+		Topic:   "api.user.create",
+		Key:     []byte("key"),
+		Value:   []byte("value"),
+		Headers: []kafka.Header{{Key: "traceparent", Value: []byte("00-trace")}},
+	}, &event.DeadLetter{
+		Topic:   "api.user.create.dlt",
+		Headers: []event.Header{{Key: event.HeaderDeadLetterError, Value: "invalid payload"}},
+	})
+
+	s.Suite.Equal("api.user.create.dlt", message.Topic)
+	s.Suite.Equal([]byte("key"), message.Key)
+	s.Suite.Equal([]byte("value"), message.Value)
+	// the dead letter headers are added to the original ones
+	s.Suite.Equal([]kafka.Header{
+		{Key: "traceparent", Value: []byte("00-trace")},
+		{Key: event.HeaderDeadLetterError, Value: []byte("invalid payload")},
+	}, message.Headers)
+}
+
+func (s *ConsumerTestSuite) TestTraceContextPropagation() {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// the producer writes the trace context of its span to the message headers
+	traceId, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	s.Suite.NoError(err)
+	spanId, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	s.Suite.NoError(err)
+	producerCtx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceId,
+		SpanID:     spanId,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	var headers []kafka.Header
+	otel.GetTextMapPropagator().Inject(producerCtx, headerCarrier{headers: &headers})
+	s.Suite.Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", headerCarrier{headers: &headers}.Get("traceparent"))
+
+	// the handler runs in the trace of the producer
+	var handled trace.SpanContext
+	consumerConfig := NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		handled = trace.SpanContextFromContext(ctx)
+		return nil
+	})
+
+	s.Suite.True(s.consumer.handle(kafka.Message{Topic: "api.user.create", Headers: headers}, consumerConfig))
+	s.Suite.Equal(traceId, handled.TraceID())
+}
+
+func TestConsumerTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsumerTestSuite))
+}
@@ -4,72 +4,83 @@ import (
 	"context"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/usecase/event"
+	"fmt"
+	"strconv"
 
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type producer struct {
-	logger            *zap.Logger
-	investmentCreated *kafka.Writer
+	logger *zap.Logger
+	events *kafka.Writer
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	return &producer{
 		logger: logger,
-		investmentCreated: &kafka.Writer{
+		// the writer has no topic of its own, every message names the topic of its event type.
+		// Events are written synchronously so that callers learn about failed deliveries
+		events: &kafka.Writer{
 			Addr:                   kafka.TCP(config.Kafka.Address...),
-			Topic:                  config.Kafka.Topic.InvestorCreate,
 			Balancer:               &kafka.Hash{},
 			RequiredAcks:           kafka.RequireAll,
 			AllowAutoTopicCreation: true,
-			Async:                  true, // make the writer asynchronous
-			Completion: func(messages []kafka.Message, err error) {
-				if err != nil {
-					logger.Error("kafka investmentCreated", zap.Error(err))
-				}
-				for _, message := range messages {
-					logger.Sugar().Info(
-						"kafka investmentCreated message",
-						zap.Int("partition", message.Partition),
-						zap.Int64("offset", message.Offset),
-						zap.String("key", string(message.Key)),
-						zap.String("value", string(message.Value)),
-					)
-				}
-			},
 		},
 	}
 }
 
-// func (p *producer) buildMessageWithTracing(key string, value []byte, otlpSpan otlp.Span) kafka.Message {
-func (p *producer) BuildMessageWithTracing(key string, value []byte) kafka.Message {
-	return kafka.Message{
-		Key:   []byte(key),
-		Value: value,
-		Headers: []kafka.Header{
-			{
-				Key: "trace_id",
-				// Value: []byte(otlpSpan.SpanContext().TraceID().String()),
-			},
-			{
-				Key: "span_id",
-				// Value: []byte(otlpSpan.SpanContext().SpanID().String()),
-			},
-		},
+// ProduceEvent publishes the event keyed by its aggregate, so events of one account stay in order.
+// The publish span continues the trace the event was recorded in and is written to the message
+// headers, the span of the caller relaying the event is linked instead.
+func (p *producer) ProduceEvent(ctx context.Context, e *entity.Event) error {
+	value, err := event.MarshalEvent(e)
+	if err != nil {
+		return err
 	}
-}
 
-func (p *producer) ProduceContent(ctx context.Context, key string, value *entity.User) error {
-	// tracing
-	// ctx, span := otlp.Start(ctx, "kafka producer", "ContentProducer")
-	// defer span.End()
+	options := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationKey.String(e.Type),
+			semconv.MessagingMessageIDKey.String(e.Id),
+			semconv.MessagingKafkaMessageKeyKey.String(e.AggregateId),
+		),
+	}
+	if len(e.TraceContext) > 0 {
+		options = append(options, trace.WithLinks(trace.LinkFromContext(ctx)))
+		ctx = otlp.Extract(ctx, e.TraceContext)
+	}
+	ctx, span := otlp.Start(ctx, producerServiceName, e.Type+" publish", options...)
+	defer span.End()
 
+	headers := []kafka.Header{
+		{Key: event.HeaderEventType, Value: []byte(e.Type)},
+		{Key: event.HeaderSchemaVersion, Value: []byte(strconv.Itoa(entity.EventSchemaVersion))},
+	}
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{headers: &headers})
+
+	err = p.events.WriteMessages(ctx, kafka.Message{
+		Topic:   e.Type,
+		Key:     []byte(e.AggregateId),
+		Value:   value,
+		Headers: headers,
+	})
+	if err != nil {
+		span.Error(err)
+		return fmt.Errorf("produce %s event: %w", e.Type, err)
+	}
 	return nil
 }
 
 func (p *producer) Close() {
-	if err := p.investmentCreated.Close(); err != nil {
-		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
+	if err := p.events.Close(); err != nil {
+		p.logger.Error("error during close writer events", zap.Error(err))
 	}
 }
@@ -0,0 +1,42 @@
+package kafka
+
+import (
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	producerServiceName = "kafkaProducer"
+	consumerServiceName = "kafkaConsumer"
+)
+
+// headerCarrier lets the propagator read and write the trace context as message headers
+type headerCarrier struct {
+	headers *[]kafka.Header
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if header.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, header := range *c.headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
@@ -0,0 +1,148 @@
+package memory_broker
+
+import (
+	"sync"
+)
+
+// Message is a message stored on a topic of the broker
+type Message struct {
+	Topic   string
+	Offset  int64
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// Broker keeps topics and consumer group offsets in process, it stands in for Kafka in tests and
+// local development. A topic has a single partition, so its messages are delivered in the order
+// they were published.
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]*topic
+	groups map[groupTopic]*group
+}
+
+type topic struct {
+	messages []Message
+	// appended is closed and replaced whenever a message is published
+	appended chan struct{}
+}
+
+type groupTopic struct {
+	group string
+	topic string
+}
+
+// group is the committed offset of a consumer group on a topic, the member consuming the topic
+// holds turn until it stops
+type group struct {
+	turn   chan struct{}
+	offset int64
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		topics: make(map[string]*topic),
+		groups: make(map[groupTopic]*group),
+	}
+}
+
+// Publish appends a message to the topic, creating the topic on first use, and returns its offset
+func (b *Broker) Publish(topicName string, key, value []byte, headers map[string]string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.topic(topicName)
+	offset := int64(len(t.messages))
+	t.messages = append(t.messages, Message{
+		Topic:   topicName,
+		Offset:  offset,
+		Key:     key,
+		Value:   value,
+		Headers: headers,
+	})
+	close(t.appended)
+	t.appended = make(chan struct{})
+
+	return offset
+}
+
+// Messages returns the messages published to the topic
+func (b *Broker) Messages(topicName string) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.topics[topicName]
+	if !ok {
+		return nil
+	}
+	return append([]Message(nil), t.messages...)
+}
+
+// Offset returns the offset of the next message the group consumes from the topic
+func (b *Broker) Offset(groupID, topicName string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if g, ok := b.groups[groupTopic{group: groupID, topic: topicName}]; ok {
+		return g.offset
+	}
+	return 0
+}
+
+// fetch returns the message at offset, or a channel closed once the next message is published
+func (b *Broker) fetch(topicName string, offset int64) (Message, bool, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.topic(topicName)
+	if offset < int64(len(t.messages)) {
+		return t.messages[offset], true, nil
+	}
+	return Message{}, false, t.appended
+}
+
+// commit moves the offset of the group past the message
+func (b *Broker) commit(groupID string, m Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	g := b.group(groupID, m.Topic)
+	if m.Offset >= g.offset {
+		g.offset = m.Offset + 1
+	}
+}
+
+// consumerGroup returns the group state, created at offset 0 on first use
+func (b *Broker) consumerGroup(groupID, topicName string) *group {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.group(groupID, topicName)
+}
+
+func (b *Broker) offset(g *group) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return g.offset
+}
+
+func (b *Broker) topic(topicName string) *topic {
+	t, ok := b.topics[topicName]
+	if !ok {
+		t = &topic{appended: make(chan struct{})}
+		b.topics[topicName] = t
+	}
+	return t
+}
+
+func (b *Broker) group(groupID, topicName string) *group {
+	key := groupTopic{group: groupID, topic: topicName}
+	g, ok := b.groups[key]
+	if !ok {
+		g = &group{turn: make(chan struct{}, 1)}
+		b.groups[key] = g
+	}
+	return g
+}
@@ -0,0 +1,174 @@
+package memory_broker
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/kafka"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/usecase/event"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type BrokerTestSuite struct {
+	suite.Suite
+	broker   *Broker
+	consumer *consumer
+}
+
+func (s *BrokerTestSuite) SetupTest() {
+	cfg := config.New()
+	cfg.Kafka.Consumer.MaxRetries = "2"
+	cfg.Kafka.Consumer.BackoffBase = "1ms"
+	cfg.Kafka.Consumer.BackoffMax = "5ms"
+	cfg.Kafka.Consumer.Workers = "2"
+
+	s.broker = NewBroker()
+	var err error
+	s.consumer, err = NewConsumer(s.broker, cfg, zap.NewNop())
+	s.Suite.NoError(err)
+}
+
+func (s *BrokerTestSuite) TearDownTest() {
+	s.Suite.NoError(s.consumer.Close(context.Background()))
+}
+
+// committed waits for the group to commit the topic up to offset
+func (s *BrokerTestSuite) committed(group, topic string, offset int64) {
+	s.Suite.Eventually(func() bool {
+		return s.broker.Offset(group, topic) == offset
+	}, time.Second, time.Millisecond)
+}
+
+func (s *BrokerTestSuite) TestProduceConsume() {
+	ctx := context.Background()
+	received := make(chan []byte, 1)
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, entity.EventUserCreated, "1", func(ctx context.Context, key, value []byte) error {
+		received <- value
+		return nil
+	}))
+	s.consumer.Run()
+
+	s.Suite.NoError(NewProducer(s.broker).ProduceEvent(ctx, &entity.Event{
+		Type:        entity.EventUserCreated,
+		AggregateId: "user-1",
+		OccurredAt:  time.Now().UTC(),
+		Payload:     map[string]string{"first_name": "Ali"},
+	}))
+
+	var message event.Message
+	s.Suite.NoError(json.Unmarshal(<-received, &message))
+	s.Suite.NotEmpty(message.Id)
+	s.Suite.Equal(entity.EventUserCreated, message.Type)
+	s.Suite.Equal(entity.EventSchemaVersion, message.Version)
+	s.Suite.Equal("user-1", message.AggregateId)
+	s.committed("1", entity.EventUserCreated, 1)
+
+	messages := s.broker.Messages(entity.EventUserCreated)
+	s.Suite.Len(messages, 1)
+	s.Suite.Equal([]byte("user-1"), messages[0].Key)
+	s.Suite.Equal(entity.EventUserCreated, messages[0].Headers[event.HeaderEventType])
+}
+
+func (s *BrokerTestSuite) TestRedelivery() {
+	var calls int32
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return errors.New("database is down")
+		}
+		return nil
+	}))
+	s.consumer.Run()
+
+	s.broker.Publish("api.user.create", nil, []byte("{}"), nil)
+	s.committed("1", "api.user.create", 1)
+	s.Suite.Equal(int32(3), atomic.LoadInt32(&calls))
+	s.Suite.Empty(s.broker.Messages("api.user.create.dlt"))
+}
+
+func (s *BrokerTestSuite) TestDeadLetter() {
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		return errors.New("invalid payload")
+	}))
+	s.consumer.Run()
+
+	s.broker.Publish("api.user.create", []byte("key"), []byte("value"), map[string]string{"traceparent": "00-trace"})
+	s.committed("1", "api.user.create", 1)
+
+	messages := s.broker.Messages("api.user.create.dlt")
+	s.Suite.Len(messages, 1)
+	s.Suite.Equal([]byte("value"), messages[0].Value)
+	s.Suite.Equal("00-trace", messages[0].Headers["traceparent"])
+	s.Suite.Equal("api.user.create", messages[0].Headers[event.HeaderDeadLetterTopic])
+	s.Suite.Equal("invalid payload", messages[0].Headers[event.HeaderDeadLetterError])
+	s.Suite.Equal("3", messages[0].Headers[event.HeaderDeadLetterAttempts])
+}
+
+func (s *BrokerTestSuite) TestConsumerGroups() {
+	var first, second int32
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		atomic.AddInt32(&first, 1)
+		return nil
+	}))
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "2", func(ctx context.Context, key, value []byte) error {
+		atomic.AddInt32(&second, 1)
+		return nil
+	}))
+	s.consumer.Run()
+
+	// every group gets every message
+	s.broker.Publish("api.user.create", nil, []byte("first"), nil)
+	s.broker.Publish("api.user.create", nil, []byte("second"), nil)
+	s.committed("1", "api.user.create", 2)
+	s.committed("2", "api.user.create", 2)
+	s.Suite.Equal(int32(2), atomic.LoadInt32(&first))
+	s.Suite.Equal(int32(2), atomic.LoadInt32(&second))
+}
+
+func (s *BrokerTestSuite) TestWorkers() {
+	released := make(chan struct{})
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		// the first message is only handled once a message with another key was handled next to it
+		if string(key) == "a" {
+			<-released
+		} else {
+			close(released)
+		}
+		return nil
+	}))
+	s.consumer.Run()
+
+	s.broker.Publish("api.user.create", []byte("a"), []byte("first"), nil)
+	s.broker.Publish("api.user.create", []byte("b"), []byte("second"), nil)
+	s.committed("1", "api.user.create", 2)
+}
+
+func (s *BrokerTestSuite) TestTraceContextPropagation() {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	handled := make(chan trace.SpanContext, 1)
+	s.consumer.RegisterConsumer(kafka.NewConsumerConfig(nil, "api.user.create", "1", func(ctx context.Context, key, value []byte) error {
+		handled <- trace.SpanContextFromContext(ctx)
+		return nil
+	}))
+	s.consumer.Run()
+
+	// the handler runs in the trace of the producer
+	s.broker.Publish("api.user.create", nil, []byte("{}"), map[string]string{
+		"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	})
+	s.Suite.Equal("4bf92f3577b34da6a3ce929d0e0e4736", (<-handled).TraceID().String())
+}
+
+func TestBrokerTestSuite(t *testing.T) {
+	suite.Run(t, new(BrokerTestSuite))
+}
@@ -0,0 +1,152 @@
+package memory_broker
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/usecase/event"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const consumerServiceName = "memoryConsumer"
+
+type consumer struct {
+	broker          *Broker
+	logger          *zap.Logger
+	consumerConfigs []event.ConsumerConfig
+	delivery        *event.Delivery
+}
+
+// NewConsumer consumes the topics of the broker with the delivery settings of the Kafka consumer
+func NewConsumer(broker *Broker, config *config.Config, logger *zap.Logger) (*consumer, error) {
+	delivery, err := event.NewDelivery(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consumer{
+		broker:   broker,
+		logger:   logger,
+		delivery: delivery,
+	}, nil
+}
+
+func (c *consumer) RegisterConsumer(consumerConfig event.ConsumerConfig) {
+	c.consumerConfigs = append(c.consumerConfigs, consumerConfig)
+}
+
+func (c *consumer) Run() {
+	for _, consumerConfig := range c.consumerConfigs {
+		consumerConfig := consumerConfig
+		c.delivery.Go(func() {
+			c.consume(consumerConfig)
+		})
+	}
+}
+
+// Close stops consuming once the current messages are handled and committed, a message waiting
+// for redelivery is left uncommitted. Messages still in progress when ctx is done are cancelled.
+func (c *consumer) Close(ctx context.Context) error {
+	return c.delivery.Close(ctx)
+}
+
+// consume handles the messages of the topic from the committed offset of the group until the
+// consumer is closed. Like the single partition of a Kafka topic, the topic is consumed by one
+// member of the group at a time, the others wait for its turn.
+func (c *consumer) consume(consumerConfig event.ConsumerConfig) {
+	g := c.broker.consumerGroup(consumerConfig.GetGroupID(), consumerConfig.GetTopic())
+	select {
+	case <-c.delivery.Stopping().Done():
+		return
+	case g.turn <- struct{}{}:
+	}
+	defer func() { <-g.turn }()
+
+	var (
+		topic    = consumerConfig.GetTopic()
+		offset   = c.broker.offset(g)
+		tracker  = event.NewOffsetTracker()
+		commitMu sync.Mutex
+	)
+	pool := event.NewKeyedPool(c.delivery.Workers(), messageKey, func(m Message) {
+		// queued messages are left uncommitted once the consumer is closed
+		if c.delivery.Stopping().Err() != nil || !c.handle(m, consumerConfig) {
+			return
+		}
+
+		commitMu.Lock()
+		defer commitMu.Unlock()
+		if commit, ok := tracker.Finish(0, m.Offset); ok {
+			c.broker.commit(consumerConfig.GetGroupID(), Message{Topic: topic, Offset: commit})
+		}
+	})
+	defer pool.Close()
+
+	for {
+		m, fetched, appended := c.broker.fetch(topic, offset)
+		if !fetched {
+			select {
+			case <-c.delivery.Stopping().Done():
+				return
+			case <-appended:
+				continue
+			}
+		}
+
+		tracker.Start(0, m.Offset)
+		pool.Submit(m)
+		offset++
+	}
+}
+
+// messageKey routes messages with the same key to the same worker
+func messageKey(m Message) []byte {
+	return m.Key
+}
+
+// handle settles the message through the shared delivery, a message that keeps failing is
+// published to the dead letter topic next to its original headers
+func (c *consumer) handle(m Message, consumerConfig event.ConsumerConfig) bool {
+	ctx, span := c.startSpan(m, consumerConfig)
+	defer span.End()
+
+	return c.delivery.Handle(ctx, span, &event.ConsumedMessage{
+		Topic:  m.Topic,
+		Offset: m.Offset,
+		Group:  consumerConfig.GetGroupID(),
+		Key:    m.Key,
+		Value:  m.Value,
+	}, consumerConfig.GetHandler(), func(ctx context.Context, deadLetter *event.DeadLetter) error {
+		headers := make(map[string]string, len(m.Headers)+len(deadLetter.Headers))
+		for key, value := range m.Headers {
+			headers[key] = value
+		}
+		for _, header := range deadLetter.Headers {
+			headers[header.Key] = header.Value
+		}
+		c.broker.Publish(deadLetter.Topic, m.Key, m.Value, headers)
+		return nil
+	})
+}
+
+// startSpan starts the span handling the message, it continues the trace found in the message
+// headers and links the producer span
+func (c *consumer) startSpan(m Message, consumerConfig event.ConsumerConfig) (context.Context, otlp.Span) {
+	ctx := otlp.Extract(c.delivery.Handling(), m.Headers)
+	return otlp.Start(ctx, consumerServiceName, m.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("memory"),
+			semconv.MessagingDestinationKey.String(consumerConfig.GetTopic()),
+			semconv.MessagingOperationProcess,
+			attribute.String("messaging.consumer_group", consumerConfig.GetGroupID()),
+			attribute.Int64("messaging.offset", m.Offset),
+		),
+	)
+}
@@ -0,0 +1,41 @@
+package memory_broker
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/usecase/event"
+	"strconv"
+)
+
+type producer struct {
+	broker *Broker
+}
+
+func NewProducer(broker *Broker) *producer {
+	return &producer{
+		broker: broker,
+	}
+}
+
+// ProduceEvent publishes the event on the topic of its type keyed by its aggregate, with the
+// envelope and headers the Kafka producer writes
+func (p *producer) ProduceEvent(ctx context.Context, e *entity.Event) error {
+	value, err := event.MarshalEvent(e)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{
+		event.HeaderEventType:     e.Type,
+		event.HeaderSchemaVersion: strconv.Itoa(entity.EventSchemaVersion),
+	}
+	for key, value := range otlp.Inject(otlp.Extract(ctx, e.TraceContext)) {
+		headers[key] = value
+	}
+
+	p.broker.Publish(e.Type, []byte(e.AggregateId), value, headers)
+	return nil
+}
+
+func (p *producer) Close() {}
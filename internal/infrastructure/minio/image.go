@@ -0,0 +1,55 @@
+package minio
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/config"
+	"fmt"
+	"net/url"
+	"path"
+
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type imageStorage struct {
+	client *miniogo.Client
+	bucket string
+}
+
+// NewImageStorage connects to the object storage serving user images, the endpoint is the
+// public url images are linked with. The credentials have no defaults, every mode needs them
+// since the purge worker removes images too.
+func NewImageStorage(cfg *config.Config) (*imageStorage, error) {
+	endpoint, err := url.Parse(cfg.MinioService.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("minio endpoint %s: %w", cfg.MinioService.Endpoint, err)
+	}
+	if endpoint.Host == "" {
+		return nil, fmt.Errorf("minio endpoint %s has no host", cfg.MinioService.Endpoint)
+	}
+	if cfg.MinioService.AccessKey == "" || cfg.MinioService.SecretKey == "" {
+		return nil, fmt.Errorf("minio credentials are not set, set MINIO_SERVICE_ACCESS_KEY and MINIO_SERVICE_SECRET_KEY")
+	}
+
+	client, err := miniogo.New(endpoint.Host, &miniogo.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioService.AccessKey, cfg.MinioService.SecretKey, ""),
+		Secure: endpoint.Scheme == "https",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("minio client: %w", err)
+	}
+
+	return &imageStorage{
+		client: client,
+		bucket: cfg.MinioService.Bucket.User,
+	}, nil
+}
+
+// Remove deletes an image by its object name, a full image url is reduced to the name
+func (i *imageStorage) Remove(ctx context.Context, name string) error {
+	name = path.Base(name)
+	if err := i.client.RemoveObject(ctx, i.bucket, name, miniogo.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("remove image %s from bucket %s: %w", name, i.bucket, err)
+	}
+	return nil
+}
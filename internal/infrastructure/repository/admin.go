@@ -13,5 +13,12 @@ type AdminStorageI interface {
 	Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error)
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error)
-	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	RehashPassword(ctx context.Context, req *entity.RehashPasswordReq) error
+	GetCredentials(ctx context.Context, req *entity.FieldValueReq) (*entity.Credentials, error)
+	RegisterFailedLogin(ctx context.Context, id string) (int64, error)
+	Lock(ctx context.Context, req *entity.LockAccountReq) error
+	Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error)
+	Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error)
+	CountActiveSuperadmins(ctx context.Context, req *entity.FieldValueReq) (*entity.SuperadminCount, error)
+	ChangeRole(ctx context.Context, req *entity.ChangeAdminRoleReq) (*entity.ChangeAdminRoleResp, error)
 }
\ No newline at end of file
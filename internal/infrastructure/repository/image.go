@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"context"
+)
+
+type ImageStorageI interface {
+	// Remove deletes the image object, removing a missing object is not an error
+	Remove(ctx context.Context, name string) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type LoginAttemptStorageI interface {
+	Create(ctx context.Context, attempt *entity.LoginAttempt) error
+	CountFailed(ctx context.Context, req *entity.CountLoginAttemptsReq) (int64, error)
+	// ClearFailed removes the failed attempts that throttle the identifiers
+	ClearFailed(ctx context.Context, req *entity.ClearLoginAttemptsReq) error
+	ListByAccount(ctx context.Context, req *entity.AccountReq) ([]*entity.LoginAttempt, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type OutboxStorageI interface {
+	// Add writes the event in the transaction carried by ctx, so it is stored only if the change it announces is
+	Add(ctx context.Context, event *entity.Event) error
+	// TryLock stops two relays from claiming the same due events, it reports false while another relay is claiming
+	TryLock(ctx context.Context) (bool, error)
+	Pending(ctx context.Context, req *entity.PendingOutboxReq) ([]*entity.OutboxMessage, error)
+	// Claim pushes the next attempt of the events past the time a relay needs to publish them
+	Claim(ctx context.Context, req *entity.ClaimOutboxReq) error
+	Delete(ctx context.Context, id string) error
+	Postpone(ctx context.Context, req *entity.OutboxFailureReq) error
+}
@@ -6,8 +6,12 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/pkg/postgres"
+	"errors"
 	"fmt"
 	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -16,6 +20,17 @@ const (
 	adminSpanRepoPrefix = "adminRepo"
 )
 
+// adminColumns lists the columns requests may filter and sort admins by
+var adminColumns = postgres.Columns{
+	Filterable: []string{"id", "role", "first_name", "last_name", "birth_date", "phone_number", "email", "gender"},
+	Sortable:   []string{"admin_order", "role", "first_name", "last_name", "birth_date", "salary", "start_work_year", "work_years", "created_at", "updated_at"},
+	Default:    "admin_order",
+	Expressions: map[string]string{
+		"work_years": "COALESCE(work_years, 0)",
+		"updated_at": "COALESCE(updated_at, created_at)",
+	},
+}
+
 type adminRepo struct {
 	tableName string
 	db        *postgres.PostgresDB
@@ -37,7 +52,6 @@ func (p *adminRepo) adminSelectQueryPrefix() string {
 			birth_date,
 			phone_number,
 			email,
-			password,
 			gender,
 			salary,
 			biography,
@@ -47,7 +61,9 @@ func (p *adminRepo) adminSelectQueryPrefix() string {
 			image_url,
 			created_at,
 			updated_at,
-			deleted_at`
+			deleted_at,
+			failed_login_attempts,
+			locked_until`
 }
 
 func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
@@ -68,7 +84,6 @@ func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
 		"start_work_year": admin.StartWorkYear,
 		"end_work_year":   admin.EndWorkYear,
 		"work_years":      admin.WorkYears,
-		"refresh_token":   admin.RefreshToken,
 		"image_url":       admin.ImageUrl,
 	}
 
@@ -88,6 +103,10 @@ func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
 func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.Admin, error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Get")
 	defer span.End()
+	field, err := adminColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		admin entity.Admin
@@ -96,7 +115,7 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 	toSql := p.db.Sq.Builder.
 		Select(p.adminSelectQueryPrefix()).
 		From(p.tableName).
-		Where(p.db.Sq.Equal(req.Field, req.Value))
+		Where(p.db.Sq.Equal(field, req.Value))
 
 	if !req.DeleteStatus {
 		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
@@ -105,7 +124,7 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 	toSqls, args, err := toSql.ToSql()
 
 	if err != nil {
-		return nil, err
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
 	}
 
 	var (
@@ -114,6 +133,7 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 		start_work_year sql.NullString
 		end_work_year   sql.NullString
 		deletedAt       sql.NullTime
+		lockedUntil     sql.NullTime
 	)
 	if err = p.db.QueryRow(ctx, toSqls, args...).Scan(
 		&admin.Id,
@@ -124,7 +144,6 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 		&birthDate,
 		&admin.PhoneNumber,
 		&admin.Email,
-		&admin.Password,
 		&admin.Gender,
 		&admin.Salary,
 		&admin.Biography,
@@ -135,6 +154,8 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 		&admin.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&admin.FailedLoginAttempts,
+		&lockedUntil,
 	); err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -154,6 +175,9 @@ func (p adminRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.
 	if deletedAt.Valid {
 		admin.DeletedAt = deletedAt.Time
 	}
+	if lockedUntil.Valid {
+		admin.LockedUntil = lockedUntil.Time
+	}
 
 	return &admin, nil
 }
@@ -170,26 +194,38 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 		Select(p.adminSelectQueryPrefix()).
 		From(p.tableName)
 
-	if req.Page >= 1 && req.Limit >= 1 {
+	where, err := p.listConditions(req)
+	if err != nil {
+		return nil, err
+	}
+	keyset, err := adminColumns.Keyset(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	toSql = toSql.Where(where).OrderBy(keyset.OrderBy()...)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(adminTableName).Where(where)
+
+	// a page token continues after its row, page and limit remain for offset based callers
+	paginated := req.Limit >= 1 && (req.PageToken != "" || req.Page >= 1)
+	switch {
+	case req.Limit >= 1 && req.PageToken != "":
+		after, err := keyset.After(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		toSql = toSql.Where(after).Limit(req.Limit)
+	case req.Limit >= 1 && req.Page >= 1:
 		toSql = toSql.
 			Limit(req.Limit).
 			Offset(req.Limit * (req.Page - 1))
 	}
-	if req.Value != "" {
-		toSql = toSql.Where(p.db.Sq.ILike(req.Field, req.Value+"%"))
-	}
-	if req.OrderBy != "" {
-		toSql = toSql.OrderBy(req.OrderBy)
-	}
-	countBuilder := p.db.Sq.Builder.Select("count(*)").From(adminTableName)
-	if !req.DeleteStatus {
-		countBuilder = countBuilder.Where("deleted_at IS NULL")
-		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
+	if paginated {
+		toSql = toSql.Column(keyset.Key())
 	}
 	toSqls, args, err := toSql.ToSql()
 
 	if err != nil {
-		return nil, err
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
 	}
 
 	rows, err := p.db.Query(ctx, toSqls, args...)
@@ -204,18 +240,22 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 		end_work_year   sql.NullString
 		count           int64
 		deletedAt       sql.NullTime
+		lockedUntil     sql.NullTime
 	)
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
 	for rows.Next() {
-		var admin entity.Admin
-		if err = rows.Scan(
+		var (
+			admin entity.Admin
+			key   []string
+		)
+		dest := []interface{}{
 			&admin.Id,
 			&admin.AdminOrder,
 			&admin.Role,
@@ -224,7 +264,6 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 			&birthDate,
 			&admin.PhoneNumber,
 			&admin.Email,
-			&admin.Password,
 			&admin.Gender,
 			&admin.Salary,
 			&admin.Biography,
@@ -235,9 +274,18 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 			&admin.CreatedAt,
 			&updatedAt,
 			&deletedAt,
-		); err != nil {
+			&admin.FailedLoginAttempts,
+			&lockedUntil,
+		}
+		if paginated {
+			dest = append(dest, &key)
+		}
+		if err = rows.Scan(dest...); err != nil {
 			return nil, p.db.Error(err)
 		}
+		if paginated {
+			admin.Cursor = keyset.Cursor(key)
+		}
 
 		if updatedAt.Valid {
 			admin.UpdatedAt = updatedAt.Time
@@ -254,6 +302,9 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 		if deletedAt.Valid {
 			admin.DeletedAt = deletedAt.Time
 		}
+		if lockedUntil.Valid {
+			admin.LockedUntil = lockedUntil.Time
+		}
 		admins = append(admins, &admin)
 		admin.Count = count
 	}
@@ -261,6 +312,26 @@ func (p adminRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.A
 	return admins, nil
 }
 
+// listConditions translates the list request into the conditions shared by the page and count queries
+func (p adminRepo) listConditions(req *entity.GetAllReq) (sq.And, error) {
+	where := p.db.Sq.And()
+	if req.Value != "" {
+		field, err := adminColumns.Field("field", req.Field)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, p.db.Sq.ILike(field+"::TEXT", req.Value+"%"))
+	}
+	if req.IsLocked {
+		where = append(where, p.db.Sq.Gt("locked_until", time.Now().UTC()))
+	}
+	if !req.DeleteStatus {
+		where = append(where, p.db.Sq.Equal("deleted_at", nil))
+	}
+
+	return where, nil
+}
+
 func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Update")
 	defer span.End()
@@ -297,7 +368,7 @@ func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return p.db.Error(fmt.Errorf("no sql rows"))
+		return p.db.Error(pgx.ErrNoRows)
 	}
 
 	return nil
@@ -306,58 +377,68 @@ func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
 func (p *adminRepo) Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Delete")
 	defer span.End()
+	field, err := adminColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 	if !req.DeleteStatus {
 		toSql, args, err := p.db.Sq.Builder.
 			Update(p.tableName).
 			Set("deleted_at", time.Now().Add(time.Hour*5)).
 			Where(p.db.Sq.EqualMany(map[string]interface{}{
 				"deleted_at": nil,
-				req.Field:    req.Value,
+				field:        req.Value,
 			})).
+			Suffix("RETURNING id").
 			ToSql()
 		if err != nil {
-			return nil, err
+			return nil, p.db.ErrSQLBuild(err, p.tableName+" delete")
 		}
 
-		_, err = p.db.Exec(ctx, toSql, args...)
+		ids, err := p.db.QueryIds(ctx, toSql, args...)
 
 		if err != nil {
-			return nil, err
+			return nil, p.db.Error(err)
 		}
-		return &entity.CheckDeleteResp{Status: true}, nil
+		return &entity.CheckDeleteResp{Status: len(ids) > 0, Ids: ids}, nil
 
 	} else {
 		toSql, args, err := p.db.Sq.Builder.
 			Delete(p.tableName).
-			Where(p.db.Sq.Equal(req.Field, req.Value)).
+			Where(p.db.Sq.Equal(field, req.Value)).
+			Suffix("RETURNING id").
 			ToSql()
 
 		if err != nil {
-			return nil, err
+			return nil, p.db.ErrSQLBuild(err, p.tableName+" delete")
 		}
 
-		_, err = p.db.Exec(ctx, toSql, args...)
+		ids, err := p.db.QueryIds(ctx, toSql, args...)
 
 		if err != nil {
-			return nil, err
+			return nil, p.db.Error(err)
 		}
-		return &entity.CheckDeleteResp{Status: true}, nil
+		return &entity.CheckDeleteResp{Status: len(ids) > 0, Ids: ids}, nil
 	}
 }
 
 func (p *adminRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"CheckField")
 	defer span.End()
+	field, err := adminColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 	query := fmt.Sprintf(`
 		SELECT count(1) 
 			FROM admins WHERE %s = $1 AND 
-			deleted_at IS NULL`, req.Field)
+			deleted_at IS NULL`, field)
 
 	var isExists int
 
 	row := p.db.QueryRow(ctx, query, req.Value)
 	if err := row.Scan(&isExists); err != nil {
-		return nil, err
+		return nil, p.db.Error(err)
 	}
 	if isExists > 0 {
 		return &entity.CheckFieldResp{
@@ -373,41 +454,276 @@ func (p *adminRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (
 func (p *adminRepo) ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ChangePassword")
 	defer span.End()
-	query := `
-		UPDATE admins
-		SET password = $1
-		WHERE (email = $2 OR phone_number = $3)
-		AND deleted_at IS NULL
-	`
 
-	resp, err := p.db.Exec(ctx, query, req.Password, req.Email, req.PhoneNumber)
+	// email is not unique, so the identifiers are resolved to one admin before anything changes
+	identifiers := p.db.Sq.Or()
+	if req.Email != "" {
+		identifiers = append(identifiers, p.db.Sq.Equal("email", req.Email))
+	}
+	if req.PhoneNumber != "" {
+		identifiers = append(identifiers, p.db.Sq.Equal("phone_number", req.PhoneNumber))
+	}
+	if len(identifiers) == 0 {
+		return &entity.ChangeAdminPasswordResp{Status: false}, nil
+	}
+
+	toSql, args, err := p.db.Sq.Builder.
+		Select("id").
+		From(p.tableName).
+		Where(identifiers).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" change password")
+	}
+	ids, err := p.db.QueryIds(ctx, toSql, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	switch len(ids) {
+	case 0:
+		return &entity.ChangeAdminPasswordResp{Status: false}, nil
+	case 1:
+	default:
+		return nil, entity.ErrorAmbiguousAdmin
+	}
+
+	toSql, args, err = p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"password":        req.Password,
+			"password_rehash": false,
+		}).
+		Where(p.db.Sq.Equal("id", ids[0])).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" change password")
+	}
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &entity.ChangeAdminPasswordResp{Status: true, Id: ids[0]}, nil
+}
+
+// RehashPassword stores a new hash for the single admin the credentials were verified for
+func (p *adminRepo) RehashPassword(ctx context.Context, req *entity.RehashPasswordReq) error {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"RehashPassword")
+	defer span.End()
+
+	toSql, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"password":        req.Password,
+			"password_rehash": false,
+		}).
+		Where(p.db.Sq.Equal("id", req.Id)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" rehash password")
+	}
+
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+func (p *adminRepo) GetCredentials(ctx context.Context, req *entity.FieldValueReq) (*entity.Credentials, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"GetCredentials")
+	defer span.End()
+	field, err := adminColumns.Field("field", req.Field)
 	if err != nil {
 		return nil, err
 	}
+	var (
+		creds       entity.Credentials
+		lockedUntil sql.NullTime
+	)
+
+	toSql := p.db.Sq.Builder.
+		Select("id", "role", "phone_number", "email", "password", "password_rehash", "failed_login_attempts", "locked_until").
+		From(p.tableName).
+		Where(p.db.Sq.Equal(field, req.Value))
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get credentials")
+	}
+
+	if err = p.db.QueryRow(ctx, toSqls, args...).Scan(
+		&creds.Id,
+		&creds.Role,
+		&creds.PhoneNumber,
+		&creds.Email,
+		&creds.Password,
+		&creds.PasswordRehash,
+		&creds.FailedLoginAttempts,
+		&lockedUntil,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+	if lockedUntil.Valid {
+		creds.LockedUntil = lockedUntil.Time
+	}
+
+	return &creds, nil
+}
+
+func (p *adminRepo) RegisterFailedLogin(ctx context.Context, id string) (int64, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"RegisterFailedLogin")
+	defer span.End()
+	query := `
+		UPDATE admins 
+		SET failed_login_attempts = failed_login_attempts + 1 
+		WHERE id = $1 
+		AND deleted_at IS NULL 
+		RETURNING failed_login_attempts`
+
+	var failedLoginAttempts int64
+	if err := p.db.QueryRow(ctx, query, id).Scan(&failedLoginAttempts); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return failedLoginAttempts, nil
+}
+
+func (p *adminRepo) Lock(ctx context.Context, req *entity.LockAccountReq) error {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Lock")
+	defer span.End()
+	query := `
+		UPDATE admins 
+		SET locked_until = $1 
+		WHERE id = $2 
+		AND deleted_at IS NULL`
+
+	_, err := p.db.Exec(ctx, query, req.LockedUntil, req.Id)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
 
+func (p *adminRepo) Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Unlock")
+	defer span.End()
+	query := `
+		UPDATE admins 
+		SET failed_login_attempts = 0, 
+		locked_until = NULL 
+		WHERE id = $1 
+		AND deleted_at IS NULL`
+
+	resp, err := p.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
 	if resp.RowsAffected() == 0 {
-		return &entity.ChangeAdminPasswordResp{Status: false}, nil
+		return &entity.UnlockAccountResp{Status: false}, nil
 	}
 
-	return &entity.ChangeAdminPasswordResp{Status: true}, nil
+	return &entity.UnlockAccountResp{Status: true}, nil
 }
 
-func (p *adminRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
-	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"UpdateRefreshToken")
+// CountActiveSuperadmins locks every active superadmin row for the rest of the transaction
+// and reports how many of them match req, so concurrent removals cannot both pass the check.
+func (p *adminRepo) CountActiveSuperadmins(ctx context.Context, req *entity.FieldValueReq) (*entity.SuperadminCount, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"CountActiveSuperadmins")
+	defer span.End()
+	field, err := adminColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := p.db.Sq.Builder.
+		Select().
+		Column(sq.Expr(field+"::TEXT = ?", req.Value)).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("role", entity.RoleSuperadmin)).
+		Where(p.db.Sq.Equal("deleted_at", nil)).
+		Where(p.db.Sq.Or(
+			p.db.Sq.Equal("end_work_year", nil),
+			sq.Expr("end_work_year > CURRENT_DATE"),
+		)).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" count active superadmins")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var count entity.SuperadminCount
+	for rows.Next() {
+		var matched bool
+		if err = rows.Scan(&matched); err != nil {
+			return nil, p.db.Error(err)
+		}
+		count.Total++
+		if matched {
+			count.Matched++
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &count, nil
+}
+
+func (p *adminRepo) ChangeRole(ctx context.Context, req *entity.ChangeAdminRoleReq) (*entity.ChangeAdminRoleResp, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ChangeRole")
 	defer span.End()
 	query := `
-			UPDATE admins 
-			SET refresh_token = $1 
-			WHERE id = $2 AND 
-			deleted_at IS NULL`
+		UPDATE admins 
+		SET role = $1, 
+		updated_at = $2 
+		WHERE id = $3 
+		AND deleted_at IS NULL`
+
+	resp, err := p.db.Exec(ctx, query, req.Role, req.UpdatedAt, req.Id)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if resp.RowsAffected() == 0 {
+		return &entity.ChangeAdminRoleResp{Status: false}, nil
+	}
 
-	resp, err := p.db.Exec(ctx, query, req.RefreshToken, req.Id)
+	return &entity.ChangeAdminRoleResp{Status: true}, nil
+}
+
+// Restore clears deleted_at of a soft deleted admin. It fails with ErrorPhoneNumberTaken when another
+// active account has registered the same phone number in the meantime.
+func (p *adminRepo) Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Restore")
+	defer span.End()
+	query := `
+		UPDATE admins 
+		SET deleted_at = NULL, 
+		updated_at = $2 
+		WHERE id = $1 
+		AND deleted_at IS NOT NULL 
+		AND anonymized_at IS NULL`
+
+	resp, err := p.db.Exec(ctx, query, req.Id, req.UpdatedAt)
 	if err != nil {
+		if err = p.db.Error(err); errors.Is(err, entity.ErrorConflict) {
+			return nil, entity.ErrorPhoneNumberTaken
+		}
 		return nil, err
 	}
 	if resp.RowsAffected() == 0 {
-		return &entity.UpdateRefreshTokenResp{Status: false}, nil
+		return &entity.RestoreAccountResp{Status: false}, nil
 	}
 
-	return &entity.UpdateRefreshTokenResp{Status: true}, nil
+	return &entity.RestoreAccountResp{Status: true}, nil
 }
@@ -3,8 +3,7 @@ package postgresql
 import (
 	"context"
 	"dennic_user_service/internal/entity"
-	"dennic_user_service/internal/pkg/config"
-	db "dennic_user_service/internal/pkg/postgres"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
 	"testing"
 	"time"
 
@@ -14,15 +13,13 @@ import (
 )
 
 type AdminReposisitoryTestSuite struct {
-	suite.Suite
-	repo        *adminRepo
-	CleanUpFunc func()
+	pgtest.Suite
+	repo *adminRepo
 }
 
 func (s *AdminReposisitoryTestSuite) SetupSuite() {
-	pgPool, _ := db.New(config.New())
-	s.repo = NewAdminRepo(pgPool)
-	s.CleanUpFunc = pgPool.Close
+	s.Suite.SetupSuite()
+	s.repo = NewAdminRepo(s.DB)
 }
 
 // test func
@@ -142,15 +139,32 @@ func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 	s.Suite.NotNil(resp_change_password_2)
 	s.Suite.Equal(resp_change_password_2.Status, true)
 
-	// // check UpdateRefreshToken admin method
-	req_update_refresh_token := entity.UpdateRefreshTokenReq{
-		Id:           updAdmin.Id,
-		RefreshToken: "new_refresh_token",
-	}
-	resp_update_refresh_token, err := s.repo.UpdateRefreshToken(ctx, &req_update_refresh_token)
+	// check RehashPassword admin method, only the admin with the id is changed
+	s.Suite.NoError(s.repo.RehashPassword(ctx, &entity.RehashPasswordReq{
+		Id:       admin.Id,
+		Password: "rehashed_password",
+	}))
+	creds, err := s.repo.GetCredentials(ctx, &entity.FieldValueReq{Field: "id", Value: admin.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal("rehashed_password", creds.Password)
+	s.Suite.False(creds.PasswordRehash)
+
+	// check ChangeRole admin method
+	resp_change_role, err := s.repo.ChangeRole(ctx, &entity.ChangeAdminRoleReq{
+		Id:        admin.Id,
+		Role:      "superadmin",
+		UpdatedAt: time.Now(),
+	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(resp_update_refresh_token)
-	s.Suite.Equal(resp_update_refresh_token.Status, true)
+	s.Suite.Equal(resp_change_role.Status, true)
+
+	// check CountActiveSuperadmins admin method, admins whose work period ended are not active
+	superadmins, err := s.repo.CountActiveSuperadmins(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: admin.Id,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(superadmins.Matched, int64(0))
 
 	// // check delete admin method
 	DeleteAdminReq := entity.FieldValueReq{
@@ -161,6 +175,58 @@ func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 	status, err := s.repo.Delete(ctx, &DeleteAdminReq)
 	s.Suite.NoError(err)
 	s.Suite.Equal(status.Status, true)
+
+	// an admin already deleted is not reported as deleted again
+	status, err = s.repo.Delete(ctx, &DeleteAdminReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(status.Status, false)
+	s.Suite.Empty(status.Ids)
+}
+
+// ChangePassword must not change two admins when the email and phone number point at different ones
+func (s *AdminReposisitoryTestSuite) TestChangePasswordAmbiguous() {
+	ctx := context.Background()
+
+	var ids []string
+	for _, phoneNumber := range []string{"ambiguous_phone_1", "ambiguous_phone_2"} {
+		admin := entity.Admin{
+			Id:            uuid.New().String(),
+			Role:          "admin",
+			FirstName:     "testdata",
+			LastName:      "testdata",
+			BirthDate:     "2000-08-30",
+			PhoneNumber:   phoneNumber,
+			Email:         "ambiguous_" + phoneNumber,
+			Password:      "testdata",
+			Gender:        "male",
+			StartWorkYear: "2000-08-30",
+			CreatedAt:     time.Now().UTC(),
+		}
+		s.Suite.NoError(s.repo.Create(ctx, &admin))
+		ids = append(ids, admin.Id)
+	}
+	defer func() {
+		for _, id := range ids {
+			_, err := s.repo.Delete(ctx, &entity.FieldValueReq{Field: "id", Value: id, DeleteStatus: true})
+			s.Suite.NoError(err)
+		}
+	}()
+
+	_, err := s.repo.ChangePassword(ctx, &entity.ChangeAdminPasswordReq{
+		Email:       "ambiguous_ambiguous_phone_1",
+		PhoneNumber: "ambiguous_phone_2",
+		Password:    "new_password",
+	})
+	s.Suite.ErrorIs(err, entity.ErrorAmbiguousAdmin)
+
+	// both identifiers of the same admin are not ambiguous
+	resp, err := s.repo.ChangePassword(ctx, &entity.ChangeAdminPasswordReq{
+		Email:       "ambiguous_ambiguous_phone_1",
+		PhoneNumber: "ambiguous_phone_1",
+		Password:    "new_password",
+	})
+	s.Suite.NoError(err)
+	s.Suite.Equal(ids[0], resp.Id)
 }
 
 func TestExampleAdminTestSuite(t *testing.T) {
@@ -0,0 +1,139 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"fmt"
+)
+
+const (
+	loginAttemptTableName      = "login_attempts"
+	loginAttemptServiceName    = "loginAttemptService"
+	loginAttemptSpanRepoPrefix = "loginAttemptRepo"
+)
+
+type loginAttemptRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewLoginAttemptRepo(db *postgres.PostgresDB) *loginAttemptRepo {
+	return &loginAttemptRepo{
+		tableName: loginAttemptTableName,
+		db:        db,
+	}
+}
+
+func (p *loginAttemptRepo) Create(ctx context.Context, attempt *entity.LoginAttempt) error {
+	ctx, span := otlp.Start(ctx, loginAttemptServiceName, loginAttemptSpanRepoPrefix+"Create")
+	defer span.End()
+	data := map[string]any{
+		"account_type": attempt.AccountType,
+		"account_id":   sql.NullString{String: attempt.AccountId, Valid: attempt.AccountId != ""},
+		"identifier":   attempt.Identifier,
+		"success":      attempt.Success,
+		"created_at":   attempt.CreatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	_, err = p.db.Exec(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p *loginAttemptRepo) CountFailed(ctx context.Context, req *entity.CountLoginAttemptsReq) (int64, error) {
+	ctx, span := otlp.Start(ctx, loginAttemptServiceName, loginAttemptSpanRepoPrefix+"CountFailed")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("count(*)").
+		From(p.tableName).
+		Where(p.db.Sq.EqualMany(map[string]interface{}{
+			"account_type": req.AccountType,
+			"identifier":   req.Identifier,
+			"success":      false,
+		})).
+		Where(p.db.Sq.Gt("created_at", req.Since)).
+		ToSql()
+	if err != nil {
+		return 0, p.db.ErrSQLBuild(err, p.tableName+" count failed")
+	}
+
+	var count int64
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return count, nil
+}
+
+func (p *loginAttemptRepo) ClearFailed(ctx context.Context, req *entity.ClearLoginAttemptsReq) error {
+	ctx, span := otlp.Start(ctx, loginAttemptServiceName, loginAttemptSpanRepoPrefix+"ClearFailed")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Delete(p.tableName).
+		Where(p.db.Sq.EqualMany(map[string]interface{}{
+			"account_type": req.AccountType,
+			"identifier":   req.Identifiers,
+			"success":      false,
+		})).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" clear failed")
+	}
+
+	if _, err = p.db.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p *loginAttemptRepo) ListByAccount(ctx context.Context, req *entity.AccountReq) ([]*entity.LoginAttempt, error) {
+	ctx, span := otlp.Start(ctx, loginAttemptServiceName, loginAttemptSpanRepoPrefix+"ListByAccount")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("account_type", "identifier", "success", "created_at").
+		From(p.tableName).
+		Where(p.db.Sq.EqualMany(map[string]interface{}{
+			"account_type": req.AccountType,
+			"account_id":   req.AccountId,
+		})).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list by account")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var attempts []*entity.LoginAttempt
+	for rows.Next() {
+		attempt := entity.LoginAttempt{AccountId: req.AccountId}
+		if err = rows.Scan(&attempt.AccountType, &attempt.Identifier, &attempt.Success, &attempt.CreatedAt); err != nil {
+			return nil, p.db.Error(err)
+		}
+		attempts = append(attempts, &attempt)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return attempts, nil
+}
@@ -0,0 +1,71 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginAttemptRepositoryTestSuite struct {
+	pgtest.Suite
+	repo *loginAttemptRepo
+}
+
+func (s *LoginAttemptRepositoryTestSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+	s.repo = NewLoginAttemptRepo(s.DB)
+}
+
+// test func
+func (s *LoginAttemptRepositoryTestSuite) TestClearFailed() {
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	accountId := uuid.New().String()
+	phoneNumber := "+998" + uuid.New().String()[:9]
+
+	for _, success := range []bool{false, false, true} {
+		s.Suite.NoError(s.repo.Create(ctx, &entity.LoginAttempt{
+			AccountType: entity.AccountTypeUser,
+			AccountId:   accountId,
+			Identifier:  phoneNumber,
+			Success:     success,
+			CreatedAt:   now,
+		}))
+	}
+	countReq := &entity.CountLoginAttemptsReq{
+		AccountType: entity.AccountTypeUser,
+		Identifier:  phoneNumber,
+		Since:       now.Add(-time.Minute),
+	}
+	failed, err := s.repo.CountFailed(ctx, countReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(int64(2), failed)
+
+	// clearing lifts the throttle and keeps the successful logins
+	s.Suite.NoError(s.repo.ClearFailed(ctx, &entity.ClearLoginAttemptsReq{
+		AccountType: entity.AccountTypeUser,
+		Identifiers: []string{phoneNumber},
+	}))
+	failed, err = s.repo.CountFailed(ctx, countReq)
+	s.Suite.NoError(err)
+	s.Suite.Zero(failed)
+
+	attempts, err := s.repo.ListByAccount(ctx, &entity.AccountReq{
+		AccountType: entity.AccountTypeUser,
+		AccountId:   accountId,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(attempts, 1)
+	s.Suite.True(attempts[0].Success)
+}
+
+func TestLoginAttemptRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptRepositoryTestSuite))
+}
@@ -0,0 +1,192 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	outboxTableName      = "outbox"
+	outboxServiceName    = "outboxService"
+	outboxSpanRepoPrefix = "outboxRepo"
+
+	// outboxLockKey names the advisory lock taken while a relay picks and claims its batch
+	outboxLockKey = "user_service.outbox"
+)
+
+type outboxRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewOutboxRepo(db *postgres.PostgresDB) *outboxRepo {
+	return &outboxRepo{
+		tableName: outboxTableName,
+		db:        db,
+	}
+}
+
+func (p *outboxRepo) Add(ctx context.Context, event *entity.Event) error {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"Add")
+	defer span.End()
+
+	var payload []byte
+	if event.Payload != nil {
+		var err error
+		if payload, err = json.Marshal(event.Payload); err != nil {
+			return fmt.Errorf("marshal %s event payload: %w", event.Type, err)
+		}
+	}
+
+	var traceContext []byte
+	if len(event.TraceContext) > 0 {
+		var err error
+		if traceContext, err = json.Marshal(event.TraceContext); err != nil {
+			return fmt.Errorf("marshal %s event trace context: %w", event.Type, err)
+		}
+	}
+
+	toSql, args, err := p.db.Sq.Builder.
+		Insert(p.tableName).
+		Columns("id", "aggregate_id", "event_type", "payload", "occurred_at", "trace_context").
+		Values(event.Id, event.AggregateId, event.Type, payload, event.OccurredAt, traceContext).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" add")
+	}
+
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	span.SetAttributes(attribute.String("event_type", event.Type))
+	return nil
+}
+
+func (p *outboxRepo) TryLock(ctx context.Context) (bool, error) {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"TryLock")
+	defer span.End()
+
+	return p.db.TryAdvisoryXactLock(ctx, outboxLockKey)
+}
+
+// Pending returns due events in the order they were written. An event is held back while an
+// earlier event of the same account waits for its next attempt, so every account sees its
+// events in order.
+func (p *outboxRepo) Pending(ctx context.Context, req *entity.PendingOutboxReq) ([]*entity.OutboxMessage, error) {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"Pending")
+	defer span.End()
+	query := `
+		SELECT id, aggregate_id, event_type, payload, occurred_at, attempts, trace_context 
+		FROM outbox o 
+		WHERE o.next_attempt_at <= $1 
+		AND NOT EXISTS (
+			SELECT 1 FROM outbox e 
+			WHERE e.aggregate_id = o.aggregate_id 
+			AND e.seq < o.seq 
+			AND e.next_attempt_at > $1
+		) 
+		ORDER BY o.seq 
+		LIMIT $2`
+
+	rows, err := p.db.Query(ctx, query, req.Now, req.Limit)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var messages []*entity.OutboxMessage
+	for rows.Next() {
+		var (
+			message      entity.OutboxMessage
+			payload      []byte
+			traceContext []byte
+		)
+		if err := rows.Scan(
+			&message.Id,
+			&message.AggregateId,
+			&message.Type,
+			&payload,
+			&message.OccurredAt,
+			&message.Attempts,
+			&traceContext,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if payload != nil {
+			message.Payload = json.RawMessage(payload)
+		}
+		if traceContext != nil {
+			if err := json.Unmarshal(traceContext, &message.TraceContext); err != nil {
+				return nil, fmt.Errorf("unmarshal %s event trace context: %w", message.Id, err)
+			}
+		}
+		messages = append(messages, &message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	span.SetAttributes(attribute.Int("pending", len(messages)))
+	return messages, nil
+}
+
+func (p *outboxRepo) Claim(ctx context.Context, req *entity.ClaimOutboxReq) error {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"Claim")
+	defer span.End()
+
+	toSql, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		Set("next_attempt_at", req.Until).
+		Where(p.db.Sq.Equal("id", req.Ids)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" claim")
+	}
+
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+// Delete removes a published event
+func (p *outboxRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"Delete")
+	defer span.End()
+
+	toSql, args, err := p.db.Sq.Builder.
+		Delete(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" delete")
+	}
+
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+func (p *outboxRepo) Postpone(ctx context.Context, req *entity.OutboxFailureReq) error {
+	ctx, span := otlp.Start(ctx, outboxServiceName, outboxSpanRepoPrefix+"Postpone")
+	defer span.End()
+	query := `
+		UPDATE outbox 
+		SET attempts = attempts + 1, 
+		next_attempt_at = $2, 
+		last_error = $3 
+		WHERE id = $1`
+
+	if _, err := p.db.Exec(ctx, query, req.Id, req.NextAttemptAt, req.Error); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRepositoryTestSuite struct {
+	pgtest.Suite
+	repo *outboxRepo
+}
+
+func (s *OutboxRepositoryTestSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+	s.repo = NewOutboxRepo(s.DB)
+}
+
+// test func
+func (s *OutboxRepositoryTestSuite) TestOutboxOrdering() {
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	aggregateId := uuid.New().String()
+
+	// two events of one account, written in order
+	first := entity.Event{
+		Id:          uuid.New().String(),
+		Type:        entity.EventUserCreated,
+		AggregateId: aggregateId,
+		OccurredAt:  now,
+		Payload:     map[string]string{"id": aggregateId},
+	}
+	second := entity.Event{
+		Id:          uuid.New().String(),
+		Type:        entity.EventUserDeleted,
+		AggregateId: aggregateId,
+		OccurredAt:  now,
+	}
+	s.Suite.NoError(s.repo.Add(ctx, &first))
+	s.Suite.NoError(s.repo.Add(ctx, &second))
+
+	pendingIds := func() []string {
+		pending, err := s.repo.Pending(ctx, &entity.PendingOutboxReq{Now: now.Add(time.Second), Limit: 1000})
+		s.Suite.NoError(err)
+		var ids []string
+		for _, message := range pending {
+			if message.AggregateId == aggregateId {
+				ids = append(ids, message.Id)
+			}
+		}
+		return ids
+	}
+	s.Suite.Equal([]string{first.Id, second.Id}, pendingIds())
+
+	// a claimed event is not due for other relays, and holds back the later events of the account
+	s.Suite.NoError(s.repo.Claim(ctx, &entity.ClaimOutboxReq{
+		Ids:   []string{first.Id},
+		Until: now.Add(time.Minute),
+	}))
+	s.Suite.Empty(pendingIds())
+
+	// a postponed event holds back the later events of the same account
+	s.Suite.NoError(s.repo.Postpone(ctx, &entity.OutboxFailureReq{
+		Id:            first.Id,
+		NextAttemptAt: now.Add(time.Hour),
+		Error:         "broker is down",
+	}))
+	s.Suite.Empty(pendingIds())
+
+	// check delete method
+	s.Suite.NoError(s.repo.Delete(ctx, first.Id))
+	s.Suite.Equal([]string{second.Id}, pendingIds())
+	s.Suite.NoError(s.repo.Delete(ctx, second.Id))
+	s.Suite.Empty(pendingIds())
+}
+
+func TestOutboxRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
@@ -0,0 +1,56 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	processedMessageTableName      = "processed_messages"
+	processedMessageServiceName    = "processedMessageService"
+	processedMessageSpanRepoPrefix = "processedMessageRepo"
+)
+
+type processedMessageRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewProcessedMessageRepo(db *postgres.PostgresDB) *processedMessageRepo {
+	return &processedMessageRepo{
+		tableName: processedMessageTableName,
+		db:        db,
+	}
+}
+
+// Claim inserts the message or, when it was recorded before, returns the recorded hash.
+// The no-op update locks a recorded row, so a concurrent claim of the same message waits
+// until the first transaction ends and then sees its row, or claims the message itself
+// when that transaction rolled back. xmax is 0 only for a freshly inserted row.
+func (p *processedMessageRepo) Claim(ctx context.Context, msg *entity.ProcessedMessage) (*entity.ClaimMessageResp, error) {
+	ctx, span := otlp.Start(ctx, processedMessageServiceName, processedMessageSpanRepoPrefix+"Claim")
+	defer span.End()
+	query := `
+		INSERT INTO processed_messages (consumer, id, payload_hash, processed_at) 
+		VALUES ($1, $2, $3, $4) 
+		ON CONFLICT (consumer, id) DO UPDATE SET id = EXCLUDED.id 
+		RETURNING (xmax = 0), payload_hash`
+
+	var resp entity.ClaimMessageResp
+	if err := p.db.QueryRow(ctx, query, msg.Consumer, msg.Id, msg.PayloadHash, msg.ProcessedAt).Scan(
+		&resp.Claimed,
+		&resp.PayloadHash,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	span.SetAttributes(
+		attribute.String("consumer", msg.Consumer),
+		attribute.Bool("claimed", resp.Claimed),
+	)
+	return &resp, nil
+}
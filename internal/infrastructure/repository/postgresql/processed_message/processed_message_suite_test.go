@@ -0,0 +1,104 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProcessedMessageRepositoryTestSuite struct {
+	pgtest.Suite
+	repo *processedMessageRepo
+}
+
+func (s *ProcessedMessageRepositoryTestSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+	s.repo = NewProcessedMessageRepo(s.DB)
+}
+
+// test func
+func (s *ProcessedMessageRepositoryTestSuite) TestProcessedMessageClaim() {
+
+	ctx := context.Background()
+	msg := entity.ProcessedMessage{
+		Consumer:    "test.consumer",
+		Id:          uuid.New().String(),
+		PayloadHash: "a665a45920422f9d417e4867efdc4fb8a04a1f3fff1fa07e998e86f7f7a27ae3",
+		ProcessedAt: time.Now().UTC(),
+	}
+
+	// the first delivery claims the message
+	resp, err := s.repo.Claim(ctx, &msg)
+	s.Suite.NoError(err)
+	s.Suite.True(resp.Claimed)
+	s.Suite.Equal(msg.PayloadHash, resp.PayloadHash)
+
+	// a redelivery gets the hash of the first delivery back
+	redelivered := msg
+	redelivered.PayloadHash = "b3a8e0e1f9ab1bfe3a36f231f676f78bb30a519d2b21e6c530c0eee8ebb4a5d0"
+	resp, err = s.repo.Claim(ctx, &redelivered)
+	s.Suite.NoError(err)
+	s.Suite.False(resp.Claimed)
+	s.Suite.Equal(msg.PayloadHash, resp.PayloadHash)
+
+	// ids are unique per consumer only
+	other := msg
+	other.Consumer = "test.other_consumer"
+	resp, err = s.repo.Claim(ctx, &other)
+	s.Suite.NoError(err)
+	s.Suite.True(resp.Claimed)
+}
+
+// a claim racing an uncommitted claim of the same message waits for it instead of failing
+func (s *ProcessedMessageRepositoryTestSuite) TestProcessedMessageConcurrentClaim() {
+
+	ctx := context.Background()
+	msg := entity.ProcessedMessage{
+		Consumer:    "test.consumer",
+		Id:          uuid.New().String(),
+		PayloadHash: "a665a45920422f9d417e4867efdc4fb8a04a1f3fff1fa07e998e86f7f7a27ae3",
+		ProcessedAt: time.Now().UTC(),
+	}
+
+	type claimResult struct {
+		resp *entity.ClaimMessageResp
+		err  error
+	}
+	second := make(chan claimResult, 1)
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		resp, err := s.repo.Claim(ctx, &msg)
+		s.Suite.NoError(err)
+		s.Suite.True(resp.Claimed)
+
+		go func() {
+			resp, err := s.repo.Claim(context.Background(), &msg)
+			second <- claimResult{resp, err}
+		}()
+
+		// the second claim blocks on the row of the first one until it commits
+		select {
+		case result := <-second:
+			s.Suite.Fail("concurrent claim returned before the first transaction ended")
+			second <- result
+		case <-time.After(200 * time.Millisecond):
+		}
+		return nil
+	})
+	s.Suite.NoError(err)
+
+	result := <-second
+	s.Suite.NoError(result.err)
+	s.Suite.False(result.resp.Claimed)
+	s.Suite.Equal(msg.PayloadHash, result.resp.PayloadHash)
+}
+
+func TestProcessedMessageRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessedMessageRepositoryTestSuite))
+}
@@ -0,0 +1,158 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	purgeServiceName    = "purgeService"
+	purgeSpanRepoPrefix = "purgeRepo"
+
+	// purgeLockKey names the advisory lock that keeps purge batches of all replicas apart
+	purgeLockKey = "user_service.purge"
+)
+
+// purgeTable describes how the accounts of one type are purged
+type purgeTable struct {
+	name string
+	// order is the column of the order number other services may show in place of the name
+	order string
+	// identifiers are the columns failed login attempts are recorded by when no account matched
+	identifiers string
+	// anonymize replaces every column holding personal data, birth dates get a fixed placeholder
+	anonymize string
+}
+
+var purgeTables = map[string]purgeTable{
+	entity.AccountTypeUser: {
+		name:        "users",
+		order:       "user_order",
+		identifiers: "ARRAY[phone_number]",
+		anonymize: `first_name = '', last_name = '', phone_number = '', password = '', image_url = NULL, 
+		birth_date = '1900-01-01', failed_login_attempts = 0, locked_until = NULL`,
+	},
+	entity.AccountTypeAdmin: {
+		name:        "admins",
+		order:       "admin_order",
+		identifiers: "ARRAY[phone_number, email]",
+		anonymize: `first_name = '', last_name = '', phone_number = '', email = '', password = '', biography = '', 
+		image_url = NULL, birth_date = '1900-01-01', failed_login_attempts = 0, locked_until = NULL`,
+	},
+}
+
+type purgeRepo struct {
+	db *postgres.PostgresDB
+}
+
+func NewPurgeRepo(db *postgres.PostgresDB) *purgeRepo {
+	return &purgeRepo{
+		db: db,
+	}
+}
+
+func (p *purgeRepo) TryLock(ctx context.Context) (bool, error) {
+	ctx, span := otlp.Start(ctx, purgeServiceName, purgeSpanRepoPrefix+"TryLock")
+	defer span.End()
+
+	return p.db.TryAdvisoryXactLock(ctx, purgeLockKey)
+}
+
+// PurgeBatch deletes or anonymizes one batch of accounts together with their refresh tokens and
+// login attempts, also the failed ones recorded only by phone number or email. Rows locked by a
+// concurrent transaction are skipped rather than waited for. The purged accounts are returned with
+// the image they had, so the caller can remove the objects from storage.
+func (p *purgeRepo) PurgeBatch(ctx context.Context, req *entity.PurgeBatchReq) ([]*entity.PurgedAccount, error) {
+	ctx, span := otlp.Start(ctx, purgeServiceName, purgeSpanRepoPrefix+"PurgeBatch")
+	defer span.End()
+
+	table, ok := purgeTables[req.AccountType]
+	if !ok {
+		return nil, fmt.Errorf("purge: unknown account type %q", req.AccountType)
+	}
+
+	var (
+		target = `
+			SELECT id, image_url, %[2]s AS identifiers FROM %[1]s 
+			WHERE deleted_at < $1 
+			%[3]s
+			ORDER BY deleted_at 
+			LIMIT $2 
+			FOR UPDATE SKIP LOCKED`
+		purge string
+		args  = []interface{}{req.DeletedBefore, req.Limit, req.AccountType}
+	)
+	switch req.Mode {
+	case entity.PurgeModeDelete:
+		target = fmt.Sprintf(target, table.name, table.identifiers, "")
+		purge = fmt.Sprintf(`
+			DELETE FROM %[1]s 
+			USING target 
+			WHERE %[1]s.id = target.id 
+			RETURNING %[1]s.id, %[1]s.%[2]s, target.image_url`, table.name, table.order)
+	case entity.PurgeModeAnonymize:
+		target = fmt.Sprintf(target, table.name, table.identifiers, "AND anonymized_at IS NULL")
+		purge = fmt.Sprintf(`
+			UPDATE %[1]s 
+			SET %[3]s, anonymized_at = $4 
+			FROM target 
+			WHERE %[1]s.id = target.id 
+			RETURNING %[1]s.id, %[1]s.%[2]s, target.image_url`, table.name, table.order, table.anonymize)
+		args = append(args, req.PurgedAt)
+	default:
+		return nil, fmt.Errorf("purge: unknown mode %q", req.Mode)
+	}
+
+	query := `
+		WITH target AS (` + target + `), 
+		purged AS (` + purge + `), 
+		tokens AS (
+			DELETE FROM refresh_tokens 
+			WHERE account_type = $3 
+			AND account_id IN (SELECT id FROM purged)
+		), 
+		attempts AS (
+			DELETE FROM login_attempts 
+			WHERE account_type = $3 
+			AND (
+				account_id IN (SELECT id FROM purged) 
+				OR identifier IN (SELECT unnest(identifiers) FROM target)
+			)
+		) 
+		SELECT id, ` + table.order + `, image_url FROM purged`
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var purged []*entity.PurgedAccount
+	for rows.Next() {
+		var (
+			account  entity.PurgedAccount
+			imageUrl sql.NullString
+		)
+		if err := rows.Scan(&account.Id, &account.Order, &imageUrl); err != nil {
+			return nil, p.db.Error(err)
+		}
+		account.ImageUrl = imageUrl.String
+		purged = append(purged, &account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	span.SetAttributes(
+		attribute.String("account_type", req.AccountType),
+		attribute.String("mode", req.Mode),
+		attribute.Int("purged", len(purged)),
+	)
+	return purged, nil
+}
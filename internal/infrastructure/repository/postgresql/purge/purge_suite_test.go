@@ -0,0 +1,176 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	userRepo "dennic_user_service/internal/infrastructure/repository/postgresql/user"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PurgeRepositoryTestSuite struct {
+	pgtest.Suite
+	repo *purgeRepo
+}
+
+func (s *PurgeRepositoryTestSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+	s.repo = NewPurgeRepo(s.DB)
+}
+
+// createDeleted creates a user soft deleted at deletedAt, long enough ago to be older than any real
+// account. The phone number and image of the user are derived from its id.
+func (s *PurgeRepositoryTestSuite) createDeleted(ctx context.Context, deletedAt time.Time) string {
+	id := uuid.New().String()
+	user := entity.User{
+		Id:          id,
+		FirstName:   "firstname",
+		LastName:    "lastname",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: "phone-" + id,
+		Password:    "testpassword",
+		Gender:      "male",
+		ImageUrl:    id + ".png",
+		CreatedAt:   time.Now().UTC(),
+	}
+	s.Suite.NoError(userRepo.NewUserRepo(s.DB).Create(ctx, &user))
+	_, err := s.DB.Exec(ctx, `UPDATE users SET deleted_at = $1 WHERE id = $2`, deletedAt, user.Id)
+	s.Suite.NoError(err)
+
+	s.T().Cleanup(func() {
+		_, err := s.DB.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, user.Id)
+		s.Suite.NoError(err)
+	})
+	return user.Id
+}
+
+// state returns the first name and birth date of a user, found is false once the row is gone
+func (s *PurgeRepositoryTestSuite) state(ctx context.Context, id string) (firstName, birthDate string, found bool) {
+	err := s.DB.QueryRow(ctx, `SELECT first_name, birth_date::TEXT FROM users WHERE id = $1`, id).Scan(&firstName, &birthDate)
+	if err == pgx.ErrNoRows {
+		return "", "", false
+	}
+	s.Suite.NoError(err)
+	return firstName, birthDate, true
+}
+
+// test func
+func (s *PurgeRepositoryTestSuite) TestPurgeDelete() {
+	ctx := context.Background()
+	cutOff := time.Date(1990, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	first := s.createDeleted(ctx, cutOff.Add(-48*time.Hour))
+	second := s.createDeleted(ctx, cutOff.Add(-24*time.Hour))
+	recent := s.createDeleted(ctx, cutOff.Add(24*time.Hour))
+
+	// a batch takes at most Limit accounts, the longest deleted first
+	accounts, err := s.repo.PurgeBatch(ctx, &entity.PurgeBatchReq{
+		AccountType:   entity.AccountTypeUser,
+		DeletedBefore: cutOff,
+		Limit:         1,
+		Mode:          entity.PurgeModeDelete,
+		PurgedAt:      time.Now().UTC(),
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(accounts, 1)
+	s.Suite.Equal(first, accounts[0].Id)
+	s.Suite.NotZero(accounts[0].Order)
+	// the image is returned so it can be removed from storage
+	s.Suite.Equal(first+".png", accounts[0].ImageUrl)
+	_, _, found := s.state(ctx, first)
+	s.Suite.False(found)
+	_, _, found = s.state(ctx, second)
+	s.Suite.True(found)
+
+	// accounts deleted after the cut-off stay
+	_, err = s.repo.PurgeBatch(ctx, &entity.PurgeBatchReq{
+		AccountType:   entity.AccountTypeUser,
+		DeletedBefore: cutOff,
+		Limit:         10,
+		Mode:          entity.PurgeModeDelete,
+		PurgedAt:      time.Now().UTC(),
+	})
+	s.Suite.NoError(err)
+	_, _, found = s.state(ctx, second)
+	s.Suite.False(found)
+	_, _, found = s.state(ctx, recent)
+	s.Suite.True(found)
+}
+
+func (s *PurgeRepositoryTestSuite) TestPurgeAnonymize() {
+	ctx := context.Background()
+	cutOff := time.Date(1990, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	old := s.createDeleted(ctx, cutOff.Add(-24*time.Hour))
+	recent := s.createDeleted(ctx, cutOff.Add(24*time.Hour))
+
+	req := entity.PurgeBatchReq{
+		AccountType:   entity.AccountTypeUser,
+		DeletedBefore: cutOff,
+		Limit:         10,
+		Mode:          entity.PurgeModeAnonymize,
+		PurgedAt:      time.Now().UTC(),
+	}
+	// a failed login recorded only by the phone number goes with the account
+	_, err := s.DB.Exec(ctx, `INSERT INTO login_attempts (account_type, identifier, success) VALUES ($1, $2, false)`,
+		entity.AccountTypeUser, "phone-"+old)
+	s.Suite.NoError(err)
+
+	accounts, err := s.repo.PurgeBatch(ctx, &req)
+	s.Suite.NoError(err)
+	s.Suite.Len(accounts, 1)
+	s.Suite.Equal(old+".png", accounts[0].ImageUrl)
+	var attempts int
+	s.Suite.NoError(s.DB.QueryRow(ctx, `SELECT COUNT(*) FROM login_attempts WHERE identifier = $1`, "phone-"+old).Scan(&attempts))
+	s.Suite.Zero(attempts)
+
+	// the row stays with its personal data replaced
+	firstName, birthDate, found := s.state(ctx, old)
+	s.Suite.True(found)
+	s.Suite.Equal("", firstName)
+	s.Suite.Equal("1900-01-01", birthDate)
+
+	firstName, birthDate, found = s.state(ctx, recent)
+	s.Suite.True(found)
+	s.Suite.Equal("firstname", firstName)
+	s.Suite.Equal("2000-08-30", birthDate)
+
+	// anonymized accounts are not picked up again
+	var anonymizedAt time.Time
+	s.Suite.NoError(s.DB.QueryRow(ctx, `SELECT anonymized_at FROM users WHERE id = $1`, old).Scan(&anonymizedAt))
+	req.PurgedAt = req.PurgedAt.Add(time.Hour)
+	_, err = s.repo.PurgeBatch(ctx, &req)
+	s.Suite.NoError(err)
+	var again time.Time
+	s.Suite.NoError(s.DB.QueryRow(ctx, `SELECT anonymized_at FROM users WHERE id = $1`, old).Scan(&again))
+	s.Suite.Equal(anonymizedAt, again)
+}
+
+// a second replica is turned away while the purge lock is held
+func (s *PurgeRepositoryTestSuite) TestTryLock() {
+	ctx := context.Background()
+
+	err := s.DB.WithTx(ctx, func(ctx context.Context) error {
+		locked, err := s.repo.TryLock(ctx)
+		s.Suite.NoError(err)
+		s.Suite.True(locked)
+
+		return s.DB.WithTx(context.Background(), func(other context.Context) error {
+			locked, err := s.repo.TryLock(other)
+			s.Suite.NoError(err)
+			s.Suite.False(locked)
+			return nil
+		})
+	})
+	s.Suite.NoError(err)
+}
+
+func TestPurgeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(PurgeRepositoryTestSuite))
+}
@@ -0,0 +1,256 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	refreshTokenTableName      = "refresh_tokens"
+	refreshTokenServiceName    = "refreshTokenService"
+	refreshTokenSpanRepoPrefix = "refreshTokenRepo"
+)
+
+type refreshTokenRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewRefreshTokenRepo(db *postgres.PostgresDB) *refreshTokenRepo {
+	return &refreshTokenRepo{
+		tableName: refreshTokenTableName,
+		db:        db,
+	}
+}
+
+// Create stores a token that starts a new family. An account keeps one active token per device,
+// so the tokens previously issued to the same device are revoked in the same transaction.
+func (p *refreshTokenRepo) Create(ctx context.Context, token *entity.RefreshToken) error {
+	ctx, span := otlp.Start(ctx, refreshTokenServiceName, refreshTokenSpanRepoPrefix+"Create")
+	defer span.End()
+	span.SetAttributes(attribute.Key("account_id").String(token.AccountId))
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	if token.DeviceId != "" {
+		query := `
+			UPDATE refresh_tokens 
+			SET revoked_at = $1 
+			WHERE account_type = $2 
+			AND account_id = $3 
+			AND device_id = $4 
+			AND rotated_at IS NULL 
+			AND revoked_at IS NULL`
+
+		_, err = tx.Exec(ctx, query, token.CreatedAt, token.AccountType, token.AccountId, token.DeviceId)
+		if err != nil {
+			return p.db.Error(err)
+		}
+	}
+
+	if err = p.insert(ctx, tx, token); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// Rotate marks the token with the given hash as used and stores next in its family.
+// Presenting a token that was already rotated means it leaked, so the whole family is revoked.
+func (p *refreshTokenRepo) Rotate(ctx context.Context, tokenHash string, next *entity.RefreshToken) (*entity.RotateRefreshTokenResp, error) {
+	ctx, span := otlp.Start(ctx, refreshTokenServiceName, refreshTokenSpanRepoPrefix+"Rotate")
+	defer span.End()
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT 
+			id, 
+			account_id, 
+			family_id, 
+			device_id, 
+			rotated_at, 
+			revoked_at, 
+			expires_at 
+		FROM refresh_tokens 
+		WHERE account_type = $1 
+		AND token_hash = $2 
+		FOR UPDATE`
+
+	var (
+		current   entity.RefreshToken
+		rotatedAt sql.NullTime
+		revokedAt sql.NullTime
+	)
+	err = tx.QueryRow(ctx, query, next.AccountType, tokenHash).Scan(
+		&current.Id,
+		&current.AccountId,
+		&current.FamilyId,
+		&current.DeviceId,
+		&rotatedAt,
+		&revokedAt,
+		&current.ExpiresAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &entity.RotateRefreshTokenResp{Status: false}, nil
+	}
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	span.SetAttributes(attribute.Key("account_id").String(current.AccountId))
+
+	if rotatedAt.Valid {
+		query = `
+			UPDATE refresh_tokens 
+			SET revoked_at = $1 
+			WHERE family_id = $2 
+			AND revoked_at IS NULL`
+
+		if _, err = tx.Exec(ctx, query, next.CreatedAt, current.FamilyId); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if err = tx.Commit(ctx); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		return &entity.RotateRefreshTokenResp{
+			Status:        false,
+			AccountId:     current.AccountId,
+			ReuseDetected: true,
+		}, nil
+	}
+
+	if revokedAt.Valid || !current.ExpiresAt.After(next.CreatedAt) {
+		return &entity.RotateRefreshTokenResp{
+			Status:    false,
+			AccountId: current.AccountId,
+		}, nil
+	}
+
+	query = `
+		UPDATE refresh_tokens 
+		SET rotated_at = $1 
+		WHERE id = $2`
+
+	if _, err = tx.Exec(ctx, query, next.CreatedAt, current.Id); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	next.AccountId = current.AccountId
+	next.FamilyId = current.FamilyId
+	next.DeviceId = current.DeviceId
+	next.ParentId = current.Id
+	if err = p.insert(ctx, tx, next); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return &entity.RotateRefreshTokenResp{
+		Status:    true,
+		AccountId: current.AccountId,
+	}, nil
+}
+
+func (p *refreshTokenRepo) insert(ctx context.Context, tx pgx.Tx, token *entity.RefreshToken) error {
+	data := map[string]any{
+		"id":           token.Id,
+		"account_id":   token.AccountId,
+		"account_type": token.AccountType,
+		"family_id":    token.FamilyId,
+		"token_hash":   token.TokenHash,
+		"device_id":    token.DeviceId,
+		"parent_id":    sql.NullString{String: token.ParentId, Valid: token.ParentId != ""},
+		"expires_at":   token.ExpiresAt,
+		"created_at":   token.CreatedAt,
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+// ListByAccount returns every token issued to the account without its hash, oldest first
+func (p *refreshTokenRepo) ListByAccount(ctx context.Context, req *entity.AccountReq) ([]*entity.RefreshToken, error) {
+	ctx, span := otlp.Start(ctx, refreshTokenServiceName, refreshTokenSpanRepoPrefix+"ListByAccount")
+	defer span.End()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "family_id", "device_id", "parent_id::TEXT", "rotated_at", "revoked_at", "expires_at", "created_at").
+		From(p.tableName).
+		Where(p.db.Sq.EqualMany(map[string]interface{}{
+			"account_type": req.AccountType,
+			"account_id":   req.AccountId,
+		})).
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list by account")
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var tokens []*entity.RefreshToken
+	for rows.Next() {
+		var (
+			token     = entity.RefreshToken{AccountId: req.AccountId, AccountType: req.AccountType}
+			parentId  sql.NullString
+			rotatedAt sql.NullTime
+			revokedAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&token.Id,
+			&token.FamilyId,
+			&token.DeviceId,
+			&parentId,
+			&rotatedAt,
+			&revokedAt,
+			&token.ExpiresAt,
+			&token.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		token.ParentId = parentId.String
+		token.RotatedAt = rotatedAt.Time
+		token.RevokedAt = revokedAt.Time
+		tokens = append(tokens, &token)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
+	"dennic_user_service/internal/pkg/token"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshTokenRepositoryTestSuite struct {
+	pgtest.Suite
+	repo *refreshTokenRepo
+}
+
+func (s *RefreshTokenRepositoryTestSuite) SetupSuite() {
+	s.Suite.SetupSuite()
+	s.repo = NewRefreshTokenRepo(s.DB)
+}
+
+// test func
+func (s *RefreshTokenRepositoryTestSuite) TestRefreshTokenRotation() {
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// struct for create refresh token
+	refreshToken := entity.RefreshToken{
+		Id:          uuid.New().String(),
+		AccountId:   uuid.New().String(),
+		AccountType: entity.AccountTypeUser,
+		FamilyId:    uuid.New().String(),
+		TokenHash:   token.Hash("first_refresh_token"),
+		DeviceId:    "test_device",
+		ExpiresAt:   now.Add(time.Hour),
+		CreatedAt:   now,
+	}
+
+	// check create refresh token method
+	err := s.repo.Create(ctx, &refreshToken)
+	s.Suite.NoError(err)
+
+	// check rotate refresh token method
+	next := entity.RefreshToken{
+		Id:          uuid.New().String(),
+		AccountType: entity.AccountTypeUser,
+		TokenHash:   token.Hash("second_refresh_token"),
+		ExpiresAt:   now.Add(time.Hour),
+		CreatedAt:   now,
+	}
+	resp, err := s.repo.Rotate(ctx, refreshToken.TokenHash, &next)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resp.Status, true)
+	s.Suite.Equal(resp.AccountId, refreshToken.AccountId)
+	s.Suite.Equal(resp.ReuseDetected, false)
+	s.Suite.Equal(next.FamilyId, refreshToken.FamilyId)
+	s.Suite.Equal(next.ParentId, refreshToken.Id)
+
+	// reusing the rotated token revokes the whole family
+	reused := entity.RefreshToken{
+		Id:          uuid.New().String(),
+		AccountType: entity.AccountTypeUser,
+		TokenHash:   token.Hash("third_refresh_token"),
+		ExpiresAt:   now.Add(time.Hour),
+		CreatedAt:   now,
+	}
+	resp, err = s.repo.Rotate(ctx, refreshToken.TokenHash, &reused)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resp.Status, false)
+	s.Suite.Equal(resp.ReuseDetected, true)
+
+	// the latest token of the family is no longer valid either
+	resp, err = s.repo.Rotate(ctx, next.TokenHash, &reused)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resp.Status, false)
+	s.Suite.Equal(resp.ReuseDetected, false)
+
+	// unknown tokens are rejected
+	resp, err = s.repo.Rotate(ctx, token.Hash("unknown_refresh_token"), &reused)
+	s.Suite.NoError(err)
+	s.Suite.Equal(resp.Status, false)
+
+}
+
+func TestRefreshTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTokenRepositoryTestSuite))
+}
@@ -6,9 +6,14 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/pkg/postgres"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -16,8 +21,21 @@ const (
 	userTableName      = "users"
 	userServiceName    = "userService"
 	userSpanRepoPrefix = "userRepo"
+
+	// searchMinPhoneDigits is the shortest digit run that is also matched against phone numbers
+	searchMinPhoneDigits = 3
 )
 
+// userColumns lists the columns requests may filter and sort users by
+var userColumns = postgres.Columns{
+	Filterable: []string{"id", "first_name", "last_name", "birth_date", "phone_number", "gender"},
+	Sortable:   []string{"user_order", "first_name", "last_name", "birth_date", "created_at", "updated_at"},
+	Default:    "user_order",
+	Expressions: map[string]string{
+		"updated_at": "COALESCE(updated_at, created_at)",
+	},
+}
+
 type userRepo struct {
 	tableName string
 	db        *postgres.PostgresDB
@@ -37,12 +55,13 @@ func (p *userRepo) userSelectQueryPrefix() string {
 			last_name,
 			birth_date,
 			phone_number,
-			password,
 			gender,
 			image_url,
 			created_at,
 			updated_at,
-			deleted_at`
+			deleted_at,
+			failed_login_attempts,
+			locked_until`
 }
 
 func (p userRepo) Create(ctx context.Context, user *entity.User) error {
@@ -51,15 +70,14 @@ func (p userRepo) Create(ctx context.Context, user *entity.User) error {
 	var userIDKey = attribute.Key("user_id")
 	span.SetAttributes(userIDKey.String(user.Id))
 	data := map[string]any{
-		"id":            user.Id,
-		"first_name":    user.FirstName,
-		"last_name":     user.LastName,
-		"birth_date":    user.BirthDate,
-		"phone_number":  user.PhoneNumber,
-		"password":      user.Password,
-		"gender":        user.Gender,
-		"refresh_token": user.RefreshToken,
-		"image_url":     user.ImageUrl,
+		"id":           user.Id,
+		"first_name":   user.FirstName,
+		"last_name":    user.LastName,
+		"birth_date":   user.BirthDate,
+		"phone_number": user.PhoneNumber,
+		"password":     user.Password,
+		"gender":       user.Gender,
+		"image_url":    user.ImageUrl,
 	}
 
 	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
@@ -78,6 +96,10 @@ func (p userRepo) Create(ctx context.Context, user *entity.User) error {
 func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Get")
 	defer span.End()
+	field, err := userColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 	var (
 		user entity.User
 	)
@@ -85,7 +107,7 @@ func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.U
 	toSql := p.db.Sq.Builder.
 		Select(p.userSelectQueryPrefix()).
 		From(p.tableName).
-		Where(p.db.Sq.Equal(req.Field, req.Value))
+		Where(p.db.Sq.Equal(field, req.Value))
 
 	if !req.DeleteStatus {
 		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
@@ -94,13 +116,14 @@ func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.U
 	toSqls, args, err := toSql.ToSql()
 
 	if err != nil {
-		return nil, err
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get")
 	}
 
 	var (
-		birthDate sql.NullString
-		updatedAt sql.NullTime
-		deletedAt sql.NullTime
+		birthDate   sql.NullString
+		updatedAt   sql.NullTime
+		deletedAt   sql.NullTime
+		lockedUntil sql.NullTime
 	)
 	if err = p.db.QueryRow(ctx, toSqls, args...).Scan(
 		&user.Id,
@@ -109,12 +132,13 @@ func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.U
 		&user.LastName,
 		&birthDate,
 		&user.PhoneNumber,
-		&user.Password,
 		&user.Gender,
 		&user.ImageUrl,
 		&user.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&user.FailedLoginAttempts,
+		&lockedUntil,
 	); err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -128,6 +152,9 @@ func (p userRepo) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.U
 	if deletedAt.Valid {
 		user.DeletedAt = deletedAt.Time
 	}
+	if lockedUntil.Valid {
+		user.LockedUntil = lockedUntil.Time
+	}
 	var userIDKey = attribute.Key("user_id")
 	span.SetAttributes(userIDKey.String(user.Id))
 	return &user, nil
@@ -143,27 +170,38 @@ func (p userRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.Us
 		Select(p.userSelectQueryPrefix()).
 		From(p.tableName)
 
-	if req.Page >= 1 && req.Limit >= 1 {
+	where, err := p.listConditions(req)
+	if err != nil {
+		return nil, err
+	}
+	keyset, err := userColumns.Keyset(req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	toSql = toSql.Where(where).OrderBy(keyset.OrderBy()...)
+	countBuilder := p.db.Sq.Builder.Select("count(*)").From(userTableName).Where(where)
+
+	// a page token continues after its row, page and limit remain for offset based callers
+	paginated := req.Limit >= 1 && (req.PageToken != "" || req.Page >= 1)
+	switch {
+	case req.Limit >= 1 && req.PageToken != "":
+		after, err := keyset.After(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		toSql = toSql.Where(after).Limit(req.Limit)
+	case req.Limit >= 1 && req.Page >= 1:
 		toSql = toSql.
 			Limit(req.Limit).
 			Offset(req.Limit * (req.Page - 1))
 	}
-	if req.Value != "" {
-		toSql = toSql.Where(p.db.Sq.ILike(req.Field, req.Value+"%"))
-	}
-	if req.OrderBy != "" {
-		toSql = toSql.OrderBy(req.OrderBy)
-	}
-	countBuilder := p.db.Sq.Builder.Select("count(*)").From(userTableName)
-
-	if !req.DeleteStatus {
-		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
-		countBuilder = countBuilder.Where("deleted_at IS NULL")
+	if paginated {
+		toSql = toSql.Column(keyset.Key())
 	}
 	toSqls, args, err := toSql.ToSql()
 
 	if err != nil {
-		return nil, err
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" list")
 	}
 	rows, err := p.db.Query(ctx, toSqls, args...)
 	if err != nil {
@@ -172,38 +210,50 @@ func (p userRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.Us
 	defer rows.Close()
 
 	var (
-		birthDate sql.NullTime
-		updatedAt sql.NullTime
-		deletedAt sql.NullTime
-		count     int64
+		birthDate   sql.NullTime
+		updatedAt   sql.NullTime
+		deletedAt   sql.NullTime
+		lockedUntil sql.NullTime
+		count       int64
 	)
-	queryCount, _, err := countBuilder.ToSql()
+	queryCount, countArgs, err := countBuilder.ToSql()
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
-	err = p.db.QueryRow(ctx, queryCount).Scan(&count)
+	err = p.db.QueryRow(ctx, queryCount, countArgs...).Scan(&count)
 	if err != nil {
 		return nil, p.db.Error(err)
 	}
 
 	for rows.Next() {
-		var user entity.User
-		if err = rows.Scan(
+		var (
+			user entity.User
+			key  []string
+		)
+		dest := []interface{}{
 			&user.Id,
 			&user.UserOrder,
 			&user.FirstName,
 			&user.LastName,
 			&birthDate,
 			&user.PhoneNumber,
-			&user.Password,
 			&user.Gender,
 			&user.ImageUrl,
 			&user.CreatedAt,
 			&updatedAt,
 			&deletedAt,
-		); err != nil {
+			&user.FailedLoginAttempts,
+			&lockedUntil,
+		}
+		if paginated {
+			dest = append(dest, &key)
+		}
+		if err = rows.Scan(dest...); err != nil {
 			return nil, p.db.Error(err)
 		}
+		if paginated {
+			user.Cursor = keyset.Cursor(key)
+		}
 
 		if birthDate.Valid {
 			user.BirthDate = birthDate.Time.String()
@@ -214,6 +264,9 @@ func (p userRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.Us
 		if deletedAt.Valid {
 			user.DeletedAt = deletedAt.Time
 		}
+		if lockedUntil.Valid {
+			user.LockedUntil = lockedUntil.Time
+		}
 		user.Count = count
 		users = append(users, &user)
 	}
@@ -221,6 +274,159 @@ func (p userRepo) List(ctx context.Context, req *entity.GetAllReq) ([]*entity.Us
 	return users, nil
 }
 
+// listConditions translates the list request into the conditions shared by the page and count queries
+func (p userRepo) listConditions(req *entity.GetAllReq) (sq.And, error) {
+	where := p.db.Sq.And()
+	if req.Value != "" {
+		field, err := userColumns.Field("field", req.Field)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, p.db.Sq.ILike(field+"::TEXT", req.Value+"%"))
+	}
+	if req.IsLocked {
+		where = append(where, p.db.Sq.Gt("locked_until", time.Now().UTC()))
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &entity.UserFilter{}
+	}
+	switch {
+	case filter.DeletedState == entity.DeletedStateDeleted:
+		where = append(where, p.db.Sq.NotEqual("deleted_at", nil))
+	case filter.DeletedState == entity.DeletedStateActive,
+		filter.DeletedState == "" && !req.DeleteStatus:
+		where = append(where, p.db.Sq.Equal("deleted_at", nil))
+	}
+	if filter.Gender != "" {
+		where = append(where, p.db.Sq.Equal("gender", filter.Gender))
+	}
+	if !filter.BirthDateFrom.IsZero() {
+		where = append(where, p.db.Sq.GtOrEq("birth_date", filter.BirthDateFrom))
+	}
+	if !filter.BirthDateTo.IsZero() {
+		where = append(where, p.db.Sq.LtOrEq("birth_date", filter.BirthDateTo))
+	}
+
+	// an age of N years means a birth date in (today - N-1 years, today - N years]
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	if filter.AgeFrom > 0 {
+		where = append(where, p.db.Sq.LtOrEq("birth_date", today.AddDate(-int(filter.AgeFrom), 0, 0)))
+	}
+	if filter.AgeTo > 0 {
+		where = append(where, p.db.Sq.Gt("birth_date", today.AddDate(-int(filter.AgeTo)-1, 0, 0)))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		where = append(where, p.db.Sq.GtOrEq("created_at", filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		where = append(where, p.db.Sq.Lt("created_at", filter.CreatedTo))
+	}
+	if filter.Name != "" {
+		name := "%" + filter.Name + "%"
+		where = append(where, p.db.Sq.Or(
+			p.db.Sq.ILike("first_name", name),
+			p.db.Sq.ILike("last_name", name),
+			p.db.Sq.ILike("first_name || ' ' || last_name", name),
+		))
+	}
+
+	return where, nil
+}
+
+// Search returns active users whose name is similar to, or whose phone number contains, any of the terms,
+// ordered by the best trigram similarity
+func (p userRepo) Search(ctx context.Context, req *entity.SearchUsersReq) ([]*entity.User, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Search")
+	defer span.End()
+
+	const fullName = "(first_name || ' ' || last_name)"
+	var (
+		users    []*entity.User
+		match    = p.db.Sq.Or()
+		rank     []string
+		rankArgs []interface{}
+	)
+	for _, term := range req.Terms {
+		// % and <% are the pg_trgm similarity operators served by users_full_name_trgm_idx
+		match = append(match,
+			sq.Expr(fullName+" % ?", term),
+			sq.Expr("? <% "+fullName, term),
+		)
+		rank = append(rank, "similarity("+fullName+", ?)", "word_similarity(?, "+fullName+")")
+		rankArgs = append(rankArgs, term, term)
+	}
+	if digits := strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, req.Query); len(digits) >= searchMinPhoneDigits {
+		match = append(match, p.db.Sq.ILike("phone_number", "%"+digits+"%"))
+	}
+
+	toSqls, args, err := p.db.Sq.Builder.
+		Select(p.userSelectQueryPrefix()).
+		From(p.tableName).
+		Where(p.db.Sq.And(match, p.db.Sq.Equal("deleted_at", nil))).
+		OrderByClause("GREATEST("+strings.Join(rank, ", ")+") DESC", rankArgs...).
+		OrderBy("user_order").
+		Limit(req.Limit).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" search")
+	}
+	rows, err := p.db.Query(ctx, toSqls, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var (
+		birthDate   sql.NullTime
+		updatedAt   sql.NullTime
+		deletedAt   sql.NullTime
+		lockedUntil sql.NullTime
+	)
+	for rows.Next() {
+		var user entity.User
+		if err = rows.Scan(
+			&user.Id,
+			&user.UserOrder,
+			&user.FirstName,
+			&user.LastName,
+			&birthDate,
+			&user.PhoneNumber,
+			&user.Gender,
+			&user.ImageUrl,
+			&user.CreatedAt,
+			&updatedAt,
+			&deletedAt,
+			&user.FailedLoginAttempts,
+			&lockedUntil,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if birthDate.Valid {
+			user.BirthDate = birthDate.Time.String()
+		}
+		if updatedAt.Valid {
+			user.UpdatedAt = updatedAt.Time
+		}
+		if lockedUntil.Valid {
+			user.LockedUntil = lockedUntil.Time
+		}
+		users = append(users, &user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	return users, nil
+}
+
 func (p userRepo) Update(ctx context.Context, user *entity.User) error {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Update")
 	defer span.End()
@@ -251,7 +457,7 @@ func (p userRepo) Update(ctx context.Context, user *entity.User) error {
 	}
 
 	if commandTag.RowsAffected() == 0 {
-		return p.db.Error(fmt.Errorf("no sql rows"))
+		return p.db.Error(pgx.ErrNoRows)
 	}
 
 	return nil
@@ -260,6 +466,10 @@ func (p userRepo) Update(ctx context.Context, user *entity.User) error {
 func (p *userRepo) Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Delete")
 	defer span.End()
+	field, err := userColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 
 	if !req.DeleteStatus {
 		toSql, args, err := p.db.Sq.Builder.
@@ -267,56 +477,56 @@ func (p *userRepo) Delete(ctx context.Context, req *entity.FieldValueReq) (*enti
 			Set("deleted_at", time.Now().Add(time.Hour*5)).
 			Where(p.db.Sq.And(
 				p.db.Sq.Equal("deleted_at", nil),
-				p.db.Sq.Equal(req.Field, req.Value),
+				p.db.Sq.Equal(field, req.Value),
 			)).
+			Suffix("RETURNING id").
 			ToSql()
 		if err != nil {
-			return nil, err
+			return nil, p.db.ErrSQLBuild(err, p.tableName+" delete")
 		}
 
-		resp, err := p.db.Exec(ctx, toSql, args...)
+		ids, err := p.db.QueryIds(ctx, toSql, args...)
 		if err != nil {
-			return nil, err
-		}
-		if resp.RowsAffected() > 0 {
-			return &entity.CheckDeleteResp{Status: true}, nil
+			return nil, p.db.Error(err)
 		}
-		return &entity.CheckDeleteResp{Status: false}, nil
+		return &entity.CheckDeleteResp{Status: len(ids) > 0, Ids: ids}, nil
 	}
 
 	// If DeleteStatus is true, then perform a delete operation
 	toSql, args, err := p.db.Sq.Builder.
 		Delete(p.tableName).
-		Where(p.db.Sq.Equal(req.Field, req.Value)).
+		Where(p.db.Sq.Equal(field, req.Value)).
+		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
-		return nil, err
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" delete")
 	}
 
-	resp, err := p.db.Exec(ctx, toSql, args...)
+	ids, err := p.db.QueryIds(ctx, toSql, args...)
 	if err != nil {
-		return nil, err
+		return nil, p.db.Error(err)
 	}
 
-	if resp.RowsAffected() > 0 {
-		return &entity.CheckDeleteResp{Status: true}, nil
-	}
-	return &entity.CheckDeleteResp{Status: false}, nil
+	return &entity.CheckDeleteResp{Status: len(ids) > 0, Ids: ids}, nil
 }
 
 func (p *userRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CheckField")
 	defer span.End()
+	field, err := userColumns.Field("field", req.Field)
+	if err != nil {
+		return nil, err
+	}
 	query := fmt.Sprintf(
 		`SELECT count(1) 
 		FROM users WHERE %s = $1 
-		AND deleted_at IS NULL`, req.Field)
+		AND deleted_at IS NULL`, field)
 
 	var isExists int
 
 	row := p.db.QueryRow(ctx, query, req.Value)
 	if err := row.Scan(&isExists); err != nil {
-		return nil, err
+		return nil, p.db.Error(err)
 	}
 
 	if isExists == 1 {
@@ -335,36 +545,226 @@ func (p *userRepo) ChangePassword(ctx context.Context, req *entity.ChangeUserPas
 	defer span.End()
 	query := `
 		UPDATE users 
-		SET password = $1 
+		SET password = $1, 
+		password_rehash = FALSE 
 		WHERE phone_number = $2 
-		AND deleted_at IS NULL
+		AND deleted_at IS NULL 
+		RETURNING id
 	`
-	resp, err := p.db.Exec(ctx, query, req.Password, req.PhoneNumber)
+	var id string
+	if err := p.db.QueryRow(ctx, query, req.Password, req.PhoneNumber).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &entity.ChangePasswordResp{Status: false}, nil
+		}
+		return nil, p.db.Error(err)
+	}
+	return &entity.ChangePasswordResp{Status: true, Id: id}, nil
+}
+
+// RehashPassword stores a new hash for the single user the credentials were verified for
+func (p *userRepo) RehashPassword(ctx context.Context, req *entity.RehashPasswordReq) error {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"RehashPassword")
+	defer span.End()
+
+	toSql, args, err := p.db.Sq.Builder.
+		Update(p.tableName).
+		SetMap(map[string]any{
+			"password":        req.Password,
+			"password_rehash": false,
+		}).
+		Where(p.db.Sq.Equal("id", req.Id)).
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, p.tableName+" rehash password")
+	}
+
+	if _, err = p.db.Exec(ctx, toSql, args...); err != nil {
+		return p.db.Error(err)
+	}
+	return nil
+}
+
+func (p *userRepo) GetCredentials(ctx context.Context, req *entity.FieldValueReq) (*entity.Credentials, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetCredentials")
+	defer span.End()
+	field, err := userColumns.Field("field", req.Field)
 	if err != nil {
 		return nil, err
 	}
+	var (
+		creds       entity.Credentials
+		lockedUntil sql.NullTime
+	)
+
+	toSql := p.db.Sq.Builder.
+		Select("id", "phone_number", "password", "password_rehash", "failed_login_attempts", "locked_until").
+		From(p.tableName).
+		Where(p.db.Sq.Equal(field, req.Value))
+
+	if !req.DeleteStatus {
+		toSql = toSql.Where(p.db.Sq.Equal("deleted_at", nil))
+	}
+
+	toSqls, args, err := toSql.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, p.tableName+" get credentials")
+	}
+
+	if err = p.db.QueryRow(ctx, toSqls, args...).Scan(
+		&creds.Id,
+		&creds.PhoneNumber,
+		&creds.Password,
+		&creds.PasswordRehash,
+		&creds.FailedLoginAttempts,
+		&lockedUntil,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+	creds.Role = entity.RoleUser
+	if lockedUntil.Valid {
+		creds.LockedUntil = lockedUntil.Time
+	}
+
+	var userIDKey = attribute.Key("user_id")
+	span.SetAttributes(userIDKey.String(creds.Id))
+	return &creds, nil
+}
+
+func (p *userRepo) RegisterFailedLogin(ctx context.Context, id string) (int64, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"RegisterFailedLogin")
+	defer span.End()
+	query := `
+		UPDATE users 
+		SET failed_login_attempts = failed_login_attempts + 1 
+		WHERE id = $1 
+		AND deleted_at IS NULL 
+		RETURNING failed_login_attempts`
+
+	var failedLoginAttempts int64
+	if err := p.db.QueryRow(ctx, query, id).Scan(&failedLoginAttempts); err != nil {
+		return 0, p.db.Error(err)
+	}
+
+	return failedLoginAttempts, nil
+}
+
+func (p *userRepo) Lock(ctx context.Context, req *entity.LockAccountReq) error {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Lock")
+	defer span.End()
+	query := `
+		UPDATE users 
+		SET locked_until = $1 
+		WHERE id = $2 
+		AND deleted_at IS NULL`
+
+	_, err := p.db.Exec(ctx, query, req.LockedUntil, req.Id)
+	if err != nil {
+		return p.db.Error(err)
+	}
+
+	return nil
+}
+
+func (p *userRepo) Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Unlock")
+	defer span.End()
+	query := `
+		UPDATE users 
+		SET failed_login_attempts = 0, 
+		locked_until = NULL 
+		WHERE id = $1 
+		AND deleted_at IS NULL`
+
+	resp, err := p.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
 	if resp.RowsAffected() == 0 {
-		return &entity.ChangePasswordResp{Status: false}, nil
+		return &entity.UnlockAccountResp{Status: false}, nil
 	}
-	return &entity.ChangePasswordResp{Status: true}, nil
+
+	return &entity.UnlockAccountResp{Status: true}, nil
 }
 
-func (p *userRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
-	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"UpdateRefreshToken")
+// Restore clears deleted_at of a soft deleted user. It fails with ErrorPhoneNumberTaken when another
+// active account has registered the same phone number in the meantime.
+func (p *userRepo) Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Restore")
 	defer span.End()
 	query := `
-			UPDATE users 
-			SET refresh_token = $1 
-			WHERE id = $2 AND 
-			deleted_at IS NULL`
+		UPDATE users 
+		SET deleted_at = NULL, 
+		updated_at = $2 
+		WHERE id = $1 
+		AND deleted_at IS NOT NULL 
+		AND anonymized_at IS NULL`
 
-	resp, err := p.db.Exec(ctx, query, req.RefreshToken, req.Id)
+	resp, err := p.db.Exec(ctx, query, req.Id, req.UpdatedAt)
 	if err != nil {
+		if err = p.db.Error(err); errors.Is(err, entity.ErrorConflict) {
+			return nil, entity.ErrorPhoneNumberTaken
+		}
 		return nil, err
 	}
 	if resp.RowsAffected() == 0 {
-		return &entity.UpdateRefreshTokenResp{Status: false}, nil
+		return &entity.RestoreAccountResp{Status: false}, nil
+	}
+
+	return &entity.RestoreAccountResp{Status: true}, nil
+}
+
+// Anonymize replaces the personal data of a user with the same placeholders the purge job uses,
+// the id and user_order stay so records of other services keep pointing at the account. The user
+// is soft deleted as well and loses every session and login attempt, including the failed ones
+// recorded only by its phone number. The image the user had is returned so the caller can remove
+// the object from storage.
+func (p *userRepo) Anonymize(ctx context.Context, req *entity.AnonymizeAccountReq) (*entity.AnonymizeAccountResp, error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Anonymize")
+	defer span.End()
+	query := `
+		WITH target AS (
+			SELECT id, phone_number, image_url FROM users 
+			WHERE id = $1 
+			AND anonymized_at IS NULL 
+			FOR UPDATE
+		), 
+		anonymized AS (
+			UPDATE users 
+			SET first_name = '', last_name = '', phone_number = '', password = '', image_url = NULL, 
+			birth_date = '1900-01-01', failed_login_attempts = 0, locked_until = NULL, 
+			deleted_at = COALESCE(deleted_at, $2), updated_at = $2, anonymized_at = $2 
+			FROM target 
+			WHERE users.id = target.id 
+			RETURNING users.id, users.user_order, target.image_url
+		), 
+		tokens AS (
+			DELETE FROM refresh_tokens 
+			WHERE account_type = $3 
+			AND account_id IN (SELECT id FROM anonymized)
+		), 
+		attempts AS (
+			DELETE FROM login_attempts 
+			WHERE account_type = $3 
+			AND (
+				account_id IN (SELECT id FROM anonymized) 
+				OR identifier IN (SELECT phone_number FROM target WHERE phone_number <> '')
+			)
+		) 
+		SELECT user_order, image_url FROM anonymized`
+
+	var (
+		resp     entity.AnonymizeAccountResp
+		imageUrl sql.NullString
+	)
+	err := p.db.QueryRow(ctx, query, req.Id, req.AnonymizedAt, entity.AccountTypeUser).Scan(&resp.UserOrder, &imageUrl)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &entity.AnonymizeAccountResp{Status: false}, nil
+		}
+		return nil, p.db.Error(err)
 	}
+	resp.Status = true
+	resp.ImageUrl = imageUrl.String
 
-	return &entity.UpdateRefreshTokenResp{Status: true}, nil
+	return &resp, nil
 }
@@ -3,8 +3,7 @@ package postgresql
 import (
 	"context"
 	"dennic_user_service/internal/entity"
-	"dennic_user_service/internal/pkg/config"
-	db "dennic_user_service/internal/pkg/postgres"
+	"dennic_user_service/internal/pkg/postgres/pgtest"
 	"testing"
 	"time"
 	"github.com/google/uuid"
@@ -13,15 +12,13 @@ import (
 )
 
 type UserReposisitoryTestSuite struct {
-	suite.Suite
-	repo      *userRepo
-	CleanUpFunc        func()
+	pgtest.Suite
+	repo *userRepo
 }
 
 func (s *UserReposisitoryTestSuite) SetupSuite() {
-	pgPool, _ := db.New(config.New())
-	s.repo = NewUserRepo(pgPool)
-	s.CleanUpFunc = pgPool.Close
+	s.Suite.SetupSuite()
+	s.repo = NewUserRepo(s.DB)
 }
 
 // test func
@@ -93,6 +90,43 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(getAllAdmins)
 
+	// check filtered getAllUsers method, the count honors the filter
+	filtered, err := s.repo.List(ctx, &entity.GetAllReq{
+		Page:  1,
+		Limit: 5,
+		Filter: &entity.UserFilter{
+			Gender:        updUser.Gender,
+			BirthDateFrom: time.Date(2000, 7, 1, 0, 0, 0, 0, time.UTC),
+			BirthDateTo:   time.Date(2000, 7, 31, 0, 0, 0, 0, time.UTC),
+			DeletedState:  entity.DeletedStateActive,
+			Name:          "updfirst",
+		},
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(filtered)
+	s.Suite.GreaterOrEqual(filtered[0].Count, int64(len(filtered)))
+	s.Suite.Equal(updUser.Id, filtered[0].Id)
+
+	// check search user method tolerates a typo in the name
+	found, err := s.repo.Search(ctx, &entity.SearchUsersReq{
+		Query: "updfirstnme",
+		Terms: []string{"updfirstnme"},
+		Limit: 5,
+	})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(found)
+
+	// check keyset pagination, the second page continues after the cursor of the first
+	firstPage, err := s.repo.List(ctx, &entity.GetAllReq{Page: 1, Limit: 1, OrderBy: "created_at desc"})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(firstPage)
+	s.Suite.NotEmpty(firstPage[0].Cursor)
+	nextPage, err := s.repo.List(ctx, &entity.GetAllReq{Limit: 1, OrderBy: "created_at desc", PageToken: firstPage[0].Cursor})
+	s.Suite.NoError(err)
+	for _, next := range nextPage {
+		s.Suite.NotEqual(firstPage[0].Id, next.Id)
+	}
+
 	// check CheckField user method
 	CheckFieldReq := entity.CheckFieldReq{
 		Value:    user.Id,
@@ -113,16 +147,36 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(resp_change_password)
 	s.Suite.Equal(resp_change_password.Status, true)
+	s.Suite.Equal(resp_change_password.Id, user.Id)
 
-	// check UpdateRefreshToken user method
-	req_update_refresh_token := entity.UpdateRefreshTokenReq{
-		Id:           updUser.Id,
-		RefreshToken: "new_refresh_token",
-	}
-	resp_update_refresh_token, err := s.repo.UpdateRefreshToken(ctx, &req_update_refresh_token)
+	// check lockout user methods
+	failed, err := s.repo.RegisterFailedLogin(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(failed, int64(1))
+
+	lockedUntil := time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+	err = s.repo.Lock(ctx, &entity.LockAccountReq{
+		Id:          user.Id,
+		LockedUntil: lockedUntil,
+	})
+	s.Suite.NoError(err)
+
+	lockedUsers, err := s.repo.List(ctx, &entity.GetAllReq{
+		Page:     1,
+		Limit:    10,
+		IsLocked: true,
+	})
 	s.Suite.NoError(err)
-	s.Suite.NotNil(resp_update_refresh_token)
-	s.Suite.Equal(resp_update_refresh_token.Status, true)
+	s.Suite.NotEmpty(lockedUsers)
+
+	resp_unlock, err := s.repo.Unlock(ctx, &entity.UnlockAccountReq{Id: user.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(resp_unlock.Status, true)
+
+	unlockedUser, err := s.repo.Get(ctx, &req)
+	s.Suite.NoError(err)
+	s.Suite.Equal(unlockedUser.FailedLoginAttempts, int64(0))
+	s.Suite.True(unlockedUser.LockedUntil.IsZero())
 
 	//check delete user method
 	DeleteAdminReq := entity.FieldValueReq{
@@ -133,7 +187,92 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	status, err := s.repo.Delete(ctx, &DeleteAdminReq)
 	s.Suite.NoError(err)
 	s.Suite.Equal(status.Status, true)
+	s.Suite.Equal(status.Ids, []string{user.Id})
+
+	// check restore user method, only soft deleted users are restored
+	restoreReq := entity.RestoreAccountReq{Id: user.Id, UpdatedAt: time.Now()}
+	restored, err := s.repo.Restore(ctx, &restoreReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(restored.Status, true)
+	restored, err = s.repo.Restore(ctx, &restoreReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(restored.Status, false)
+
+	// restoring fails once another active user has taken the phone number
+	status, err = s.repo.Delete(ctx, &DeleteAdminReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(status.Status, true)
+	claimer := user
+	claimer.Id = uuid.New().String()
+	claimer.PhoneNumber = updUser.PhoneNumber
+	s.Suite.NoError(s.repo.Create(ctx, &claimer))
+	_, err = s.repo.Restore(ctx, &restoreReq)
+	s.Suite.ErrorIs(err, entity.ErrorPhoneNumberTaken)
+	_, err = s.repo.Delete(ctx, &entity.FieldValueReq{Field: "id", Value: claimer.Id, DeleteStatus: true})
+	s.Suite.NoError(err)
+
+	// check anonymize user method, the id stays and the account can no longer be restored
+	anonymizeReq := entity.AnonymizeAccountReq{Id: user.Id, AnonymizedAt: time.Now()}
+	anonymized, err := s.repo.Anonymize(ctx, &anonymizeReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(anonymized.Status, true)
+	s.Suite.NotZero(anonymized.UserOrder)
+	anonymized, err = s.repo.Anonymize(ctx, &anonymizeReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(anonymized.Status, false)
+	restored, err = s.repo.Restore(ctx, &restoreReq)
+	s.Suite.NoError(err)
+	s.Suite.Equal(restored.Status, false)
+	_, err = s.repo.Delete(ctx, &entity.FieldValueReq{Field: "id", Value: user.Id, DeleteStatus: true})
+	s.Suite.NoError(err)
+
+}
+
+// anonymizing removes the login attempts of the user, also the failed ones recorded only by phone number
+func (s *UserReposisitoryTestSuite) TestAnonymizeLoginAttempts() {
+	ctx := context.Background()
+
+	user := entity.User{
+		Id:          uuid.New().String(),
+		FirstName:   "firstname",
+		LastName:    "lastname",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: uuid.New().String()[:13],
+		Password:    "testpassword",
+		Gender:      "male",
+		CreatedAt:   time.Now().UTC(),
+	}
+	other := uuid.New().String()[:13]
+	s.Suite.NoError(s.repo.Create(ctx, &user))
+	s.T().Cleanup(func() {
+		_, err := s.DB.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, user.Id)
+		s.Suite.NoError(err)
+		_, err = s.DB.Exec(context.Background(), `DELETE FROM login_attempts WHERE identifier = $1`, other)
+		s.Suite.NoError(err)
+	})
+
+	_, err := s.DB.Exec(ctx, `
+		INSERT INTO login_attempts (account_type, account_id, identifier, success) VALUES 
+		($1, $2, $3, true), 
+		($1, NULL, $3, false), 
+		($1, NULL, $4, false)`,
+		entity.AccountTypeUser, user.Id, user.PhoneNumber, other)
+	s.Suite.NoError(err)
+
+	anonymized, err := s.repo.Anonymize(ctx, &entity.AnonymizeAccountReq{Id: user.Id, AnonymizedAt: time.Now()})
+	s.Suite.NoError(err)
+	s.Suite.True(anonymized.Status)
+
+	var remaining int
+	s.Suite.NoError(s.DB.QueryRow(ctx,
+		`SELECT COUNT(*) FROM login_attempts WHERE account_id = $1 OR identifier = $2`,
+		user.Id, user.PhoneNumber).Scan(&remaining))
+	s.Suite.Zero(remaining)
 
+	// attempts of other identifiers stay
+	s.Suite.NoError(s.DB.QueryRow(ctx,
+		`SELECT COUNT(*) FROM login_attempts WHERE identifier = $1`, other).Scan(&remaining))
+	s.Suite.Equal(1, remaining)
 }
 
 func TestExampleUserTestSuite(t *testing.T) {
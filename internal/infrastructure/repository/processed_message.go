@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type ProcessedMessageStorageI interface {
+	// Claim records the message in the transaction carried by ctx, a message recorded before is
+	// not claimed again and its first payload hash is returned instead
+	Claim(ctx context.Context, msg *entity.ProcessedMessage) (*entity.ClaimMessageResp, error)
+}
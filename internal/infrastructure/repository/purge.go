@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type PurgeStorageI interface {
+	// TryLock takes the purge lock for the transaction carried by ctx, it reports false when another replica holds it
+	TryLock(ctx context.Context) (bool, error)
+	PurgeBatch(ctx context.Context, req *entity.PurgeBatchReq) ([]*entity.PurgedAccount, error)
+}
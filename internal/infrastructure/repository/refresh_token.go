@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type RefreshTokenStorageI interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	Rotate(ctx context.Context, tokenHash string, next *entity.RefreshToken) (*entity.RotateRefreshTokenResp, error)
+	ListByAccount(ctx context.Context, req *entity.AccountReq) ([]*entity.RefreshToken, error)
+}
@@ -9,9 +9,16 @@ type UserStorageI interface {
 	Create(ctx context.Context, user *entity.User) (error)
 	Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error)
 	List(ctx context.Context, req *entity.GetAllReq) ([]*entity.User, error)
+	Search(ctx context.Context, req *entity.SearchUsersReq) ([]*entity.User, error)
 	Update(ctx context.Context, kyc *entity.User) error
 	Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error)
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error)
-	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	RehashPassword(ctx context.Context, req *entity.RehashPasswordReq) error
+	GetCredentials(ctx context.Context, req *entity.FieldValueReq) (*entity.Credentials, error)
+	RegisterFailedLogin(ctx context.Context, id string) (int64, error)
+	Lock(ctx context.Context, req *entity.LockAccountReq) error
+	Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error)
+	Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error)
+	Anonymize(ctx context.Context, req *entity.AnonymizeAccountReq) (*entity.AnonymizeAccountResp, error)
 }
@@ -2,14 +2,18 @@ package app
 
 import (
 	"context"
+	"dennic_user_service/internal/entity"
 )
 
 type ctxKeyLocalization int
 
+type ctxKeyCaller int
+
 const (
 	EnvironmentProduction                    = "production"
 	EnvironmentDevelop                       = "develop"
 	CtxKeyLocalization    ctxKeyLocalization = 0
+	CtxKeyCaller          ctxKeyCaller       = 0
 )
 
 func GetLocalizationFromContext(ctx context.Context) string {
@@ -18,3 +22,12 @@ func GetLocalizationFromContext(ctx context.Context) string {
 	}
 	return ""
 }
+
+func ContextWithCaller(ctx context.Context, caller entity.Caller) context.Context {
+	return context.WithValue(ctx, CtxKeyCaller, caller)
+}
+
+func GetCallerFromContext(ctx context.Context) (entity.Caller, bool) {
+	caller, ok := ctx.Value(CtxKeyCaller).(entity.Caller)
+	return caller, ok
+}
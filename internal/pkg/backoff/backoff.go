@@ -0,0 +1,31 @@
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Exponential doubles the delay with every attempt starting from Base and never exceeds Max.
+// Jitter is the fraction of the delay that is randomized, so retries of many callers spread out.
+type Exponential struct {
+	Base   time.Duration
+	Max    time.Duration
+	Jitter float64
+}
+
+// Delay returns how long to wait before the given retry, attempt 0 being the first retry
+func (e Exponential) Delay(attempt int) time.Duration {
+	delay := e.Base
+	for i := 0; i < attempt && delay < e.Max; i++ {
+		delay *= 2
+	}
+	if e.Max > 0 && delay > e.Max {
+		delay = e.Max
+	}
+
+	if e.Jitter > 0 && delay > 0 {
+		spread := time.Duration(float64(delay) * e.Jitter)
+		delay = delay - spread + time.Duration(rand.Int63n(int64(spread)*2+1))
+	}
+	return delay
+}
@@ -0,0 +1,34 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BackoffTestSuite struct {
+	suite.Suite
+}
+
+func (s *BackoffTestSuite) TestDelay() {
+	b := Exponential{Base: time.Second, Max: time.Minute}
+	s.Suite.Equal(time.Second, b.Delay(0))
+	s.Suite.Equal(2*time.Second, b.Delay(1))
+	s.Suite.Equal(32*time.Second, b.Delay(5))
+	s.Suite.Equal(time.Minute, b.Delay(6))
+	s.Suite.Equal(time.Minute, b.Delay(1000))
+}
+
+func (s *BackoffTestSuite) TestJitter() {
+	b := Exponential{Base: time.Second, Max: time.Minute, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		delay := b.Delay(3)
+		s.Suite.GreaterOrEqual(delay, 4*time.Second)
+		s.Suite.LessOrEqual(delay, 12*time.Second)
+	}
+}
+
+func TestBackoffTestSuite(t *testing.T) {
+	suite.Run(t, new(BackoffTestSuite))
+}
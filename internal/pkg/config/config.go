@@ -6,8 +6,10 @@ import (
 )
 
 type Minio struct {
-	Endpoint string
-	Bucket   struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    struct {
 		User string
 	}
 }
@@ -17,9 +19,34 @@ type Config struct {
 	Environment string
 	LogLevel    string
 	RPCPort     string
+	// Mode selects the components to run: grpc, consumer or all
+	Mode string
+	// Broker selects the event broker: kafka, or memory for tests and local development
+	Broker string
 
 	Context struct {
 		Timeout string
+		// ShutdownTimeout bounds the graceful shutdown, work still running afterwards is cancelled
+		ShutdownTimeout string
+	}
+
+	Token struct {
+		RefreshTTL string
+		SigningKey string
+	}
+
+	Purge struct {
+		Retention string
+		Interval  string
+		BatchSize string
+		Mode      string
+	}
+
+	Outbox struct {
+		Interval    string
+		BatchSize   string
+		BackoffBase string
+		BackoffMax  string
 	}
 
 	DB struct {
@@ -31,15 +58,24 @@ type Config struct {
 		SslMode  string
 	}
 
+	BookingService struct {
+		Host string
+		Port string
+	}
+
 	OTLPCollector struct {
 		Host string
 		Port string
 	}
 
 	Kafka struct {
-		Address []string
-		Topic   struct {
-			InvestorCreate string
+		Address  []string
+		Consumer struct {
+			MaxRetries       string
+			BackoffBase      string
+			BackoffMax       string
+			DeadLetterSuffix string
+			Workers          string
 		}
 	}
 	MinioService Minio
@@ -53,7 +89,27 @@ func New() *Config {
 	c.Environment = getEnv("ENVIRONMENT", "develop")
 	c.LogLevel = getEnv("LOG_LEVEL", "debug")
 	c.RPCPort = getEnv("RPC_PORT", ":9070")
+	c.Mode = getEnv("APP_MODE", "all")
+	c.Broker = getEnv("BROKER", "kafka")
 	c.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
+	c.Context.ShutdownTimeout = getEnv("SHUTDOWN_TIMEOUT", "20s")
+
+	// token configuration
+	c.Token.RefreshTTL = getEnv("REFRESH_TOKEN_TTL", "720h")
+	// secrets have no defaults, the app refuses to start without them
+	c.Token.SigningKey = getEnv("TOKEN_SIGNING_KEY", "")
+
+	// purge configuration, soft deleted accounts older than the retention are deleted or anonymized
+	c.Purge.Retention = getEnv("PURGE_RETENTION", "2160h")
+	c.Purge.Interval = getEnv("PURGE_INTERVAL", "1h")
+	c.Purge.BatchSize = getEnv("PURGE_BATCH_SIZE", "500")
+	c.Purge.Mode = getEnv("PURGE_MODE", "delete")
+
+	// outbox configuration, events are relayed every interval and retried with exponential backoff
+	c.Outbox.Interval = getEnv("OUTBOX_INTERVAL", "1s")
+	c.Outbox.BatchSize = getEnv("OUTBOX_BATCH_SIZE", "100")
+	c.Outbox.BackoffBase = getEnv("OUTBOX_BACKOFF_BASE", "1s")
+	c.Outbox.BackoffMax = getEnv("OUTBOX_BACKOFF_MAX", "5m")
 
 	// db configuration
 	c.DB.Host = getEnv("POSTGRES_HOST", "postgresdb")
@@ -63,16 +119,28 @@ func New() *Config {
 	c.DB.SslMode = getEnv("POSTGRES_SSLMODE", "disable")
 	c.DB.Name = getEnv("POSTGRES_DATABASE", "dennic")
 
+	// booking service configuration
+	c.BookingService.Host = getEnv("BOOKING_SERVICE_HOST", "booking_service")
+	c.BookingService.Port = getEnv("BOOKING_SERVICE_PORT", ":9080")
+
 	// otlp collector configuration
 	c.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "otel-collector")
 	c.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":4317")
 
 	// kafka configuration
 	c.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	c.Kafka.Topic.InvestorCreate = getEnv("KAFKA_TOPIC_INVESTOR_CREATE", "investor.created")
+	// a message failing every retry is moved to the topic named after it with the dead letter suffix
+	c.Kafka.Consumer.MaxRetries = getEnv("KAFKA_CONSUMER_MAX_RETRIES", "5")
+	c.Kafka.Consumer.BackoffBase = getEnv("KAFKA_CONSUMER_BACKOFF_BASE", "500ms")
+	c.Kafka.Consumer.BackoffMax = getEnv("KAFKA_CONSUMER_BACKOFF_MAX", "30s")
+	c.Kafka.Consumer.DeadLetterSuffix = getEnv("KAFKA_CONSUMER_DEAD_LETTER_SUFFIX", ".dlt")
+	// messages of a topic are handled by the workers in parallel, messages with the same key in order
+	c.Kafka.Consumer.Workers = getEnv("KAFKA_CONSUMER_WORKERS", "8")
 
 	// Minio
 	c.MinioService.Endpoint = getEnv("MINIO_SERVICE_ENDPOINT", "https://minio.dennic.uz")
+	c.MinioService.AccessKey = getEnv("MINIO_SERVICE_ACCESS_KEY", "")
+	c.MinioService.SecretKey = getEnv("MINIO_SERVICE_SECRET_KEY", "")
 	c.MinioService.Bucket.User = getEnv("MINIO_SERVICE_BUCKET_USER", "user")
 
 	return &c
@@ -16,3 +16,11 @@ func RemoveImageUrl(imageUrl string) string {
 	str := strings.Split(imageUrl, "/")
 	return str[len(str)-1]
 }
+
+// UserImageUrl links an image stored in the user bucket, an empty image stays empty
+func UserImageUrl(imageUrl string) string {
+	if imageUrl == "" {
+		return ""
+	}
+	return AddImageUrl(imageUrl, cfg.MinioService.Bucket.User)
+}
@@ -0,0 +1,27 @@
+package otlp
+
+import (
+	"context"
+
+	otelpkg "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Inject returns the trace context of ctx in the form of the global propagator, it is empty
+// when ctx carries no span
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otelpkg.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract returns ctx continuing the trace context written by Inject
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otelpkg.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
@@ -15,8 +15,8 @@ type Span interface {
 	Error(err error)
 }
 
-func Start(ctx context.Context, name, spanName string) (context.Context, Span) {
-	ctx, _span := otelpkg.Tracer(name).Start(ctx, spanName)
+func Start(ctx context.Context, name, spanName string, options ...trace.SpanStartOption) (context.Context, Span) {
+	ctx, _span := otelpkg.Tracer(name).Start(ctx, spanName, options...)
 	return ctx, &span{span: _span}
 }
 
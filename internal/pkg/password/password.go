@@ -0,0 +1,154 @@
+// Package password provides hashing and verification of account passwords.
+//
+// Hashes are stored in the PHC string format:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+//
+// so the algorithm and its parameters travel with every stored value and
+// can be upgraded without breaking existing hashes.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	algorithm = "argon2id"
+
+	memory     uint32 = 64 * 1024
+	iterations uint32 = 3
+	threads    uint8  = 2
+	saltLen           = 16
+	keyLen     uint32 = 32
+)
+
+var (
+	ErrEmptyPassword = errors.New("password is empty")
+	ErrInvalidHash   = errors.New("password hash is not in the expected format")
+)
+
+type params struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	keyLen  uint32
+}
+
+var current = params{
+	memory:  memory,
+	time:    iterations,
+	threads: threads,
+	keyLen:  keyLen,
+}
+
+// Hash returns an argon2id hash of the plain password encoded as a PHC string.
+func Hash(plain string) (string, error) {
+	if plain == "" {
+		return "", ErrEmptyPassword
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("password salt generation: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(plain), salt, current.time, current.memory, current.threads, current.keyLen)
+
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		algorithm,
+		argon2.Version,
+		current.memory,
+		current.time,
+		current.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Compare reports whether plain matches the encoded hash. The comparison runs
+// in constant time. Values that are not argon2id hashes are treated as legacy
+// plaintext passwords written before hashing was introduced.
+func Compare(encoded, plain string) (bool, error) {
+	if !IsHash(encoded) {
+		return subtle.ConstantTimeCompare([]byte(encoded), []byte(plain)) == 1, nil
+	}
+
+	p, salt, key, err := decode(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey([]byte(plain), salt, p.time, p.memory, p.threads, p.keyLen)
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// CompareUnknown performs the same amount of work as Compare without a stored
+// hash, so lookups of unknown accounts take as long as a failed comparison.
+func CompareUnknown(plain string) {
+	argon2.IDKey([]byte(plain), make([]byte, saltLen), current.time, current.memory, current.threads, current.keyLen)
+}
+
+// NeedsRehash reports whether the encoded value should be replaced with a
+// fresh hash, either because it is a legacy plaintext value or because it was
+// produced with outdated parameters.
+func NeedsRehash(encoded string) bool {
+	if !IsHash(encoded) {
+		return true
+	}
+
+	p, _, _, err := decode(encoded)
+	if err != nil {
+		return true
+	}
+
+	return p != current
+}
+
+// IsHash reports whether the value looks like a hash produced by this package.
+func IsHash(encoded string) bool {
+	return strings.HasPrefix(encoded, "$"+algorithm+"$")
+}
+
+func decode(encoded string) (params, []byte, []byte, error) {
+	var (
+		p       params
+		version int
+	)
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != algorithm {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	if version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2 version %d: %w", version, ErrInvalidHash)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, ErrInvalidHash
+	}
+	p.keyLen = uint32(len(key))
+
+	return p, salt, key, nil
+}
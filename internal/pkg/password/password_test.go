@@ -0,0 +1,62 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordTestSuite struct {
+	suite.Suite
+}
+
+func (s *PasswordTestSuite) TestHashAndCompare() {
+	hash, err := Hash("secret-password")
+	s.Suite.NoError(err)
+	s.Suite.True(strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=2$"))
+	s.Suite.True(IsHash(hash))
+	s.Suite.False(NeedsRehash(hash))
+
+	ok, err := Compare(hash, "secret-password")
+	s.Suite.NoError(err)
+	s.Suite.True(ok)
+
+	ok, err = Compare(hash, "wrong-password")
+	s.Suite.NoError(err)
+	s.Suite.False(ok)
+
+	// salts differ between calls
+	other, err := Hash("secret-password")
+	s.Suite.NoError(err)
+	s.Suite.NotEqual(hash, other)
+}
+
+func (s *PasswordTestSuite) TestEmptyPassword() {
+	_, err := Hash("")
+	s.Suite.ErrorIs(err, ErrEmptyPassword)
+}
+
+func (s *PasswordTestSuite) TestLegacyPlaintext() {
+	s.Suite.True(NeedsRehash("password123"))
+
+	ok, err := Compare("password123", "password123")
+	s.Suite.NoError(err)
+	s.Suite.True(ok)
+
+	ok, err = Compare("password123", "password12")
+	s.Suite.NoError(err)
+	s.Suite.False(ok)
+}
+
+func (s *PasswordTestSuite) TestOutdatedParams() {
+	outdated := "$argon2id$v=19$m=4096,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"
+	s.Suite.True(NeedsRehash(outdated))
+
+	_, err := Compare("$argon2id$broken", "x")
+	s.Suite.ErrorIs(err, ErrInvalidHash)
+}
+
+func TestPasswordTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordTestSuite))
+}
@@ -88,9 +88,13 @@ func (p *PostgresDB) Error(err error) error {
 		}
 	}
 
-	if err == pgx.ErrNoRows {
+	if errors.Is(err, pgx.ErrNoRows) {
 		return entity.ErrorNotFound
 	}
+	// statement timeouts and cancelled queries surface as the context error
+	if pgconn.Timeout(err) && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("%w: %s", context.DeadlineExceeded, err.Error())
+	}
 	return err
 }
 
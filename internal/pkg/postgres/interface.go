@@ -14,3 +14,5 @@ type RepoTx interface {
 	Begin(ctx context.Context) (Tx, error)
 	TxRollback(ctx context.Context, tx Tx, err error) error
 }
+
+var _ RepoTx = (*PostgresDB)(nil)
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// keysetTieBreaker is appended to every keyset ordering so that rows with equal sort keys keep a stable order
+const keysetTieBreaker = "id"
+
+var errInvalidPageToken = errors.New("page token is invalid")
+
+// Keyset is a validated ordering of a listing that can be continued after a row with an opaque cursor.
+// Cursors hold the text form of the sort key of a row, so pages stay stable while rows are inserted.
+type Keyset struct {
+	spec  string
+	exprs []string
+	desc  []bool
+}
+
+type cursor struct {
+	Order string   `json:"o"`
+	Key   []string `json:"k"`
+}
+
+// Keyset parses the sort spec like OrderBy, falling back to the Default spec when it is empty
+func (c Columns) Keyset(spec string) (*Keyset, error) {
+	if strings.TrimSpace(spec) == "" {
+		spec = c.Default
+	}
+	clauses, err := c.OrderBy(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Keyset{spec: strings.Join(clauses, ", ")}
+	for _, clause := range append(clauses, keysetTieBreaker+" ASC") {
+		column, direction, _ := strings.Cut(clause, " ")
+		expr := column
+		if e, ok := c.Expressions[column]; ok {
+			expr = e
+		}
+		k.exprs = append(k.exprs, expr)
+		k.desc = append(k.desc, direction == "DESC")
+	}
+
+	return k, nil
+}
+
+// OrderBy returns the ORDER BY clauses, ending with the tie breaker
+func (k *Keyset) OrderBy() []string {
+	clauses := make([]string, 0, len(k.exprs))
+	for i, expr := range k.exprs {
+		if k.desc[i] {
+			clauses = append(clauses, expr+" DESC")
+		} else {
+			clauses = append(clauses, expr+" ASC")
+		}
+	}
+	return clauses
+}
+
+// Key returns the column to select so that every row can be turned into a cursor with Cursor
+func (k *Keyset) Key() string {
+	values := make([]string, 0, len(k.exprs))
+	for _, expr := range k.exprs {
+		values = append(values, "("+expr+")::TEXT")
+	}
+	return "ARRAY[" + strings.Join(values, ", ") + "]"
+}
+
+// Cursor encodes the selected key of a row into an opaque page token
+func (k *Keyset) Cursor(key []string) string {
+	data, _ := json.Marshal(cursor{Order: k.spec, Key: key})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// After returns the condition selecting the rows that follow the row the token was made from.
+// A token made for a different ordering is rejected with an ErrValidation.
+func (k *Keyset) After(token string) (sq.Sqlizer, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalidPageToken("malformed page token")
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, invalidPageToken("malformed page token")
+	}
+	if c.Order != k.spec || len(c.Key) != len(k.exprs) {
+		return nil, invalidPageToken("page token was issued for a different order_by")
+	}
+
+	// (a, b, id) after (x, y, z) expands to a > x OR (a = x AND b > y) OR (a = x AND b = y AND id > z),
+	// with < in place of > for descending columns
+	after := sq.Or{}
+	for i := range k.exprs {
+		cond := sq.And{}
+		for j := 0; j < i; j++ {
+			cond = append(cond, sq.Expr(k.exprs[j]+" = ?", c.Key[j]))
+		}
+		op := " > ?"
+		if k.desc[i] {
+			op = " < ?"
+		}
+		after = append(after, append(cond, sq.Expr(k.exprs[i]+op, c.Key[i])))
+	}
+
+	return after, nil
+}
+
+func invalidPageToken(description string) error {
+	return invalid("page_token", description, errInvalidPageToken)
+}
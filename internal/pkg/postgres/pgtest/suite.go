@@ -0,0 +1,30 @@
+// Package pgtest provides the base of the repository test suites that need a live database
+package pgtest
+
+import (
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// Suite connects to the database configured by the environment, suites embedding it are
+// skipped when the database cannot be reached
+type Suite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *Suite) SetupSuite() {
+	db, err := postgres.New(config.New())
+	if err != nil {
+		s.T().Skipf("postgres is not available: %v", err)
+	}
+	s.DB = db
+}
+
+func (s *Suite) TearDownSuite() {
+	if s.DB != nil {
+		s.DB.Close()
+	}
+}
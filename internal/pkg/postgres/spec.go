@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"dennic_user_service/internal/entity"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errUnknownColumn = errors.New("request refers to an unknown column")
+
+// Columns is the allowlist of the columns of one table that requests may refer to.
+// Only names listed here ever reach the SQL text.
+type Columns struct {
+	Filterable []string
+	Sortable   []string
+	// Default is the sort spec keyset listings use when the request has none
+	Default string
+	// Expressions replaces a sortable column in keyset listings, e.g. to give NULLs a comparable value
+	Expressions map[string]string
+}
+
+// Field returns the column a request filters on, or an ErrValidation naming the request field.
+func (c Columns) Field(field, name string) (string, error) {
+	if !contains(c.Filterable, name) {
+		return "", invalid(field, fmt.Sprintf("unknown field %q, allowed: %s", name, strings.Join(c.Filterable, ", ")), errUnknownColumn)
+	}
+	return name, nil
+}
+
+// OrderBy parses a comma separated sort spec such as "first_name desc, created_at" into
+// ORDER BY clauses. Directions default to ASC.
+func (c Columns) OrderBy(spec string) ([]string, error) {
+	var clauses []string
+	if strings.TrimSpace(spec) == "" {
+		return clauses, nil
+	}
+
+	for _, part := range strings.Split(spec, ",") {
+		tokens := strings.Fields(part)
+		if len(tokens) == 0 || len(tokens) > 2 {
+			return nil, invalid("order_by", fmt.Sprintf("malformed sort expression %q", strings.TrimSpace(part)), errUnknownColumn)
+		}
+
+		column := strings.ToLower(tokens[0])
+		if !contains(c.Sortable, column) {
+			return nil, invalid("order_by", fmt.Sprintf("unknown sort field %q, allowed: %s", tokens[0], strings.Join(c.Sortable, ", ")), errUnknownColumn)
+		}
+
+		direction := "ASC"
+		if len(tokens) == 2 {
+			direction = strings.ToUpper(tokens[1])
+			if direction != "ASC" && direction != "DESC" {
+				return nil, invalid("order_by", fmt.Sprintf("unknown sort direction %q, allowed: asc, desc", tokens[1]), errUnknownColumn)
+			}
+		}
+		clauses = append(clauses, column+" "+direction)
+	}
+
+	return clauses, nil
+}
+
+func invalid(field, description string, cause error) error {
+	err := entity.NewErrValidation()
+	err.Err = cause
+	err.Errors[field] = description
+	return err
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
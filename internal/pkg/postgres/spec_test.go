@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"dennic_user_service/internal/entity"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SpecTestSuite struct {
+	suite.Suite
+	columns Columns
+}
+
+func (s *SpecTestSuite) SetupSuite() {
+	s.columns = Columns{
+		Filterable: []string{"id", "first_name"},
+		Sortable:   []string{"first_name", "created_at", "updated_at"},
+		Default:    "created_at",
+		Expressions: map[string]string{
+			"updated_at": "COALESCE(updated_at, created_at)",
+		},
+	}
+}
+
+func (s *SpecTestSuite) TestField() {
+	column, err := s.columns.Field("field", "first_name")
+	s.Suite.NoError(err)
+	s.Suite.Equal("first_name", column)
+
+	_, err = s.columns.Field("field", "id = id OR 1=1 --")
+	var errValidation *entity.ErrValidation
+	s.Suite.ErrorAs(err, &errValidation)
+	s.Suite.Contains(errValidation.Errors, "field")
+}
+
+func (s *SpecTestSuite) TestOrderBy() {
+	clauses, err := s.columns.OrderBy("first_name desc, created_at")
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{"first_name DESC", "created_at ASC"}, clauses)
+
+	clauses, err = s.columns.OrderBy("  ")
+	s.Suite.NoError(err)
+	s.Suite.Empty(clauses)
+
+	for _, spec := range []string{
+		"password",
+		"first_name sideways",
+		"first_name desc nulls",
+		"first_name,,created_at",
+		"created_at; DROP TABLE users",
+	} {
+		_, err = s.columns.OrderBy(spec)
+		var errValidation *entity.ErrValidation
+		s.Suite.ErrorAs(err, &errValidation, spec)
+		s.Suite.Contains(errValidation.Errors, "order_by", spec)
+	}
+}
+
+func (s *SpecTestSuite) TestKeyset() {
+	keyset, err := s.columns.Keyset("")
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{"created_at ASC", "id ASC"}, keyset.OrderBy())
+
+	keyset, err = s.columns.Keyset("updated_at desc")
+	s.Suite.NoError(err)
+	s.Suite.Equal([]string{"COALESCE(updated_at, created_at) DESC", "id ASC"}, keyset.OrderBy())
+	s.Suite.Equal("ARRAY[(COALESCE(updated_at, created_at))::TEXT, (id)::TEXT]", keyset.Key())
+
+	token := keyset.Cursor([]string{"2024-01-02 10:00:00", "123e4567-e89b-12d3-a456-426614174001"})
+	after, err := keyset.After(token)
+	s.Suite.NoError(err)
+	query, args, err := after.ToSql()
+	s.Suite.NoError(err)
+	s.Suite.Equal("((COALESCE(updated_at, created_at) < ?) OR (COALESCE(updated_at, created_at) = ? AND id > ?))", query)
+	s.Suite.Equal([]interface{}{"2024-01-02 10:00:00", "2024-01-02 10:00:00", "123e4567-e89b-12d3-a456-426614174001"}, args)
+
+	other, err := s.columns.Keyset("first_name")
+	s.Suite.NoError(err)
+	for _, token := range []string{token, "not a token", ""} {
+		_, err = other.After(token)
+		var errValidation *entity.ErrValidation
+		s.Suite.ErrorAs(err, &errValidation, token)
+		s.Suite.Contains(errValidation.Errors, "page_token", token)
+	}
+}
+
+func TestSpecTestSuite(t *testing.T) {
+	suite.Run(t, new(SpecTestSuite))
+}
@@ -65,6 +65,14 @@ func (s *Squirrel) Lt(key string, value interface{}) sq.Lt {
 	return sq.Lt{key: value}
 }
 
+func (s *Squirrel) GtOrEq(key string, value interface{}) sq.GtOrEq {
+	return sq.GtOrEq{key: value}
+}
+
+func (s *Squirrel) LtOrEq(key string, value interface{}) sq.LtOrEq {
+	return sq.LtOrEq{key: value}
+}
+
 func (s *Squirrel) Expr(sql string, args ...interface{}) sq.Sqlizer {
 	return sq.Expr(sql, args)
 }
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+type ctxKeyTx int
+
+const CtxKeyTx ctxKeyTx = 0
+
+// Begin starts a transaction, or a savepoint when ctx already carries one.
+func (p *PostgresDB) Begin(ctx context.Context) (Tx, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.Begin(ctx)
+	}
+	return p.Pool.Begin(ctx)
+}
+
+// TxRollback rolls the transaction back and returns err, joined with the rollback error if any.
+func (p *PostgresDB) TxRollback(ctx context.Context, tx Tx, err error) error {
+	if rbErr := tx.Rollback(ctx); rbErr != nil && rbErr != pgx.ErrTxClosed {
+		return fmt.Errorf("%w, rollback: %s", err, rbErr.Error())
+	}
+	return err
+}
+
+// WithTx runs fn inside a transaction carried by its context, so every repository
+// call made with that context joins it. The transaction is committed when fn succeeds.
+func (p *PostgresDB) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := p.Begin(ctx)
+	if err != nil {
+		return p.Error(err)
+	}
+
+	if err := fn(context.WithValue(ctx, CtxKeyTx, tx)); err != nil {
+		return p.TxRollback(ctx, tx, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return p.TxRollback(ctx, tx, p.Error(err))
+	}
+
+	return nil
+}
+
+func TxFromContext(ctx context.Context) (Tx, bool) {
+	tx, ok := ctx.Value(CtxKeyTx).(Tx)
+	return tx, ok
+}
+
+// Exec, Query and QueryRow run on the transaction carried by ctx, falling back to the pool.
+
+func (p *PostgresDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.Exec(ctx, sql, args...)
+	}
+	return p.Pool.Exec(ctx, sql, args...)
+}
+
+func (p *PostgresDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.Query(ctx, sql, args...)
+	}
+	return p.Pool.Query(ctx, sql, args...)
+}
+
+func (p *PostgresDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, sql, args...)
+	}
+	return p.Pool.QueryRow(ctx, sql, args...)
+}
+
+// QueryIds runs a statement returning a single text column, such as an UPDATE or DELETE
+// with RETURNING id, and collects the values
+func (p *PostgresDB) QueryIds(ctx context.Context, sql string, args ...interface{}) ([]string, error) {
+	rows, err := p.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// TryAdvisoryXactLock takes the advisory lock named key for the transaction carried by ctx and
+// reports false when another session holds it. The lock is released when that transaction ends.
+func (p *PostgresDB) TryAdvisoryXactLock(ctx context.Context, key string) (bool, error) {
+	if _, ok := TxFromContext(ctx); !ok {
+		return false, fmt.Errorf("advisory lock %q needs a transaction", key)
+	}
+
+	var locked bool
+	if err := p.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext($1))`, key).Scan(&locked); err != nil {
+		return false, p.Error(err)
+	}
+	return locked, nil
+}
@@ -0,0 +1,17 @@
+// Package token derives the values stored for opaque refresh tokens.
+//
+// Refresh tokens are long random strings issued by the gateway, so a single
+// unsalted SHA-256 is enough to make a database dump useless while keeping
+// lookups by hash possible.
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hash returns the hex encoded SHA-256 of the token.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
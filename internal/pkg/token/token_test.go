@@ -0,0 +1,22 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenTestSuite struct {
+	suite.Suite
+}
+
+func (s *TokenTestSuite) TestHash() {
+	// must stay in sync with encode(sha256(...), 'hex') used by the refresh_tokens migration
+	s.Suite.Equal("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", Hash("hello"))
+	s.Suite.Len(Hash(""), 64)
+	s.Suite.NotEqual(Hash("token-a"), Hash("token-b"))
+}
+
+func TestTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenTestSuite))
+}
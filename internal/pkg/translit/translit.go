@@ -0,0 +1,113 @@
+// Package translit converts between the Latin and Cyrillic spellings of Uzbek,
+// so that a name typed in one alphabet also finds records stored in the other.
+//
+// The conversion follows the official 1995 Latin alphabet. It is lossy by
+// design (щ and ш both become sh, ь is dropped), which is fine for matching
+// but not for displaying names.
+package translit
+
+import (
+	"strings"
+	"unicode"
+)
+
+// apostrophes are the characters people type for the Uzbek tutuq belgisi and the o‘, g‘ letters
+var apostrophes = strings.NewReplacer("‘", "'", "’", "'", "ʻ", "'", "ʼ", "'", "`", "'")
+
+// latinDigraphs are matched before single letters when converting to Cyrillic
+var latinDigraphs = map[string]string{
+	"o'": "ў", "g'": "ғ", "sh": "ш", "ch": "ч",
+	"yo": "ё", "yu": "ю", "ya": "я", "ye": "е", "ts": "ц",
+}
+
+var latinLetters = map[rune]string{
+	'a': "а", 'b': "б", 'd': "д", 'e': "е", 'f': "ф", 'g': "г", 'h': "ҳ",
+	'i': "и", 'j': "ж", 'k': "к", 'l': "л", 'm': "м", 'n': "н", 'o': "о",
+	'p': "п", 'q': "қ", 'r': "р", 's': "с", 't': "т", 'u': "у", 'v': "в",
+	'x': "х", 'y': "й", 'z': "з", 'c': "с", 'w': "в", '\'': "ъ",
+}
+
+// cyrillicVowels are followed by ye rather than e, as in Чориев -> Choriyev
+const cyrillicVowels = "аеёиоуўэюя"
+
+var cyrillicLetters = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'ғ': "g'", 'д': "d", 'е': "e",
+	'ё': "yo", 'ж': "j", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'қ': "q",
+	'л': "l", 'м': "m", 'н': "n", 'о': "o", 'ў': "o'", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "x", 'ҳ': "h", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "sh", 'ъ': "'", 'ь': "", 'ы': "i", 'э': "e",
+	'ю': "yu", 'я': "ya",
+}
+
+// Normalize lower cases s, trims it and unifies apostrophe variants
+func Normalize(s string) string {
+	return apostrophes.Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// ToCyrillic converts the Latin letters of a normalized string, other characters are kept
+func ToCyrillic(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		if i+1 < len(runes) {
+			if cyr, ok := latinDigraphs[string(runes[i:i+2])]; ok {
+				b.WriteString(cyr)
+				i++
+				continue
+			}
+		}
+		if runes[i] == 'e' && wordStart(runes, i) {
+			b.WriteString("э")
+			continue
+		}
+		if cyr, ok := latinLetters[runes[i]]; ok {
+			b.WriteString(cyr)
+			continue
+		}
+		b.WriteRune(runes[i])
+	}
+	return b.String()
+}
+
+// ToLatin converts the Cyrillic letters of a normalized string, other characters are kept
+func ToLatin(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if r == 'е' && (wordStart(runes, i) || strings.ContainsRune(cyrillicVowels, runes[i-1])) {
+			b.WriteString("ye")
+			continue
+		}
+		if lat, ok := cyrillicLetters[r]; ok {
+			b.WriteString(lat)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Variants returns the normalized query followed by its spellings in both alphabets, without duplicates
+func Variants(s string) []string {
+	normalized := Normalize(s)
+	variants := []string{normalized}
+	for _, v := range []string{ToLatin(normalized), ToCyrillic(normalized)} {
+		if !contains(variants, v) {
+			variants = append(variants, v)
+		}
+	}
+	return variants
+}
+
+func wordStart(runes []rune, i int) bool {
+	return i == 0 || !unicode.IsLetter(runes[i-1])
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
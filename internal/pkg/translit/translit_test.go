@@ -0,0 +1,37 @@
+package translit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TranslitTestSuite struct {
+	suite.Suite
+}
+
+func (s *TranslitTestSuite) TestToCyrillic() {
+	s.Suite.Equal("шоҳруҳ", ToCyrillic("shohruh"))
+	s.Suite.Equal("ғулом", ToCyrillic("g'ulom"))
+	s.Suite.Equal("ўткир", ToCyrillic("o'tkir"))
+	s.Suite.Equal("эргаш чориев", ToCyrillic("ergash choriev"))
+	s.Suite.Equal("ёқубжон", ToCyrillic("yoqubjon"))
+	s.Suite.Equal("+998901234567", ToCyrillic("+998901234567"))
+}
+
+func (s *TranslitTestSuite) TestToLatin() {
+	s.Suite.Equal("shohruh", ToLatin("шоҳруҳ"))
+	s.Suite.Equal("g'ulom", ToLatin("ғулом"))
+	s.Suite.Equal("yelena", ToLatin("елена"))
+	s.Suite.Equal("ergash choriyev", ToLatin("эргаш чориев"))
+}
+
+func (s *TranslitTestSuite) TestVariants() {
+	s.Suite.Equal([]string{"o'tkir", "ўткир"}, Variants("  Oʻtkir "))
+	s.Suite.Equal([]string{"ўткир", "o'tkir"}, Variants("Ўткир"))
+	s.Suite.Equal([]string{"998901234567"}, Variants("998901234567"))
+}
+
+func TestTranslitTestSuite(t *testing.T) {
+	suite.Run(t, new(TranslitTestSuite))
+}
@@ -5,12 +5,17 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/repository"
 	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/password"
+	"errors"
+	"fmt"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
 	AdminServiceName = "adminService"
-	AdinSpanName    = "adminUsecase"
+	AdinSpanName     = "adminUsecase"
 )
 
 type AdminStorageI interface {
@@ -22,18 +27,45 @@ type AdminStorageI interface {
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	RotateRefreshToken(ctx context.Context, req *entity.RotateRefreshTokenReq) (*entity.RotateRefreshTokenResp, error)
+	ChangeRole(ctx context.Context, req *entity.ChangeAdminRoleReq) (*entity.ChangeAdminRoleResp, error)
+	VerifyCredentials(ctx context.Context, req *entity.VerifyCredentialsReq) (*entity.VerifyCredentialsResp, error)
+	Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error)
+	Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error)
 }
 
+// roleTransitions lists the roles an admin may be moved to from its current role
+var roleTransitions = map[string][]string{
+	entity.RoleAdmin:      {entity.RoleSuperadmin},
+	entity.RoleSuperadmin: {entity.RoleAdmin},
+}
 
 type adminService struct {
-	repo       repository.AdminStorageI
-	ctxTimeout time.Duration
+	repo          repository.AdminStorageI
+	transactor    repository.Transactor
+	lockout       lockout
+	refreshTokens refreshTokens
+	outbox        outbox
+	ctxTimeout    time.Duration
 }
 
-func NewAdminService(ctxTimeout time.Duration, repo repository.AdminStorageI) adminService {
+func NewAdminService(ctxTimeout time.Duration, repo repository.AdminStorageI, loginAttemptRepo repository.LoginAttemptStorageI, refreshTokenRepo repository.RefreshTokenStorageI, refreshTokenTTL time.Duration, outboxRepo repository.OutboxStorageI, transactor repository.Transactor) adminService {
 	return adminService{
 		ctxTimeout: ctxTimeout,
 		repo:       repo,
+		transactor: transactor,
+		lockout: lockout{
+			accountType: entity.AccountTypeAdmin,
+			attempts:    loginAttemptRepo,
+		},
+		refreshTokens: refreshTokens{
+			accountType: entity.AccountTypeAdmin,
+			repo:        refreshTokenRepo,
+			ttl:         refreshTokenTTL,
+		},
+		outbox: outbox{
+			repo: outboxRepo,
+		},
 	}
 }
 
@@ -43,9 +75,29 @@ func (a adminService) Create(ctx context.Context, admin *entity.Admin) (string,
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Create")
 	defer span.End()
-	adminId := admin.Id
 
-	return adminId, a.repo.Create(ctx, admin)
+	hash, err := password.Hash(admin.Password)
+	if err != nil {
+		return "", err
+	}
+	admin.Password = hash
+
+	err = a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := a.repo.Create(ctx, admin); err != nil {
+			return err
+		}
+		if admin.RefreshToken != "" {
+			if err := a.refreshTokens.issue(ctx, admin.Id, admin.RefreshToken, ""); err != nil {
+				return err
+			}
+		}
+		return a.addAdminEvent(ctx, entity.EventAdminCreated, admin.Id)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return admin.Id, nil
 }
 
 func (a adminService) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.Admin, error) {
@@ -75,7 +127,18 @@ func (a adminService) Update(ctx context.Context, req *entity.Admin) error {
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Update")
 	defer span.End()
 
-	return a.repo.Update(ctx, req)
+	return a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		// a work period that has already ended deactivates the admin
+		if workPeriodEnded(req.EndWorkYear, time.Now()) {
+			if err := a.ensureSuperadminRemains(ctx, &entity.FieldValueReq{Field: "id", Value: req.Id}); err != nil {
+				return err
+			}
+		}
+		if err := a.repo.Update(ctx, req); err != nil {
+			return err
+		}
+		return a.addAdminEvent(ctx, entity.EventAdminUpdated, req.Id)
+	})
 }
 
 func (a adminService) Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error) {
@@ -85,7 +148,33 @@ func (a adminService) Delete(ctx context.Context, req *entity.FieldValueReq) (*e
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Delete")
 	defer span.End()
 
-	return a.repo.Delete(ctx, req)
+	payload := deletedEventPayload{Mode: entity.DeleteModeSoft}
+	if req.DeleteStatus {
+		payload.Mode = entity.DeleteModeHard
+	}
+
+	var resp *entity.CheckDeleteResp
+	err := a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := a.ensureSuperadminRemains(ctx, req); err != nil {
+			return err
+		}
+
+		var err error
+		if resp, err = a.repo.Delete(ctx, req); err != nil {
+			return err
+		}
+		for _, id := range resp.Ids {
+			if err := a.outbox.add(ctx, entity.EventAdminDeleted, id, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (a adminService) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
@@ -105,7 +194,24 @@ func (a adminService) ChangePassword(ctx context.Context, req *entity.ChangeAdmi
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ChangePassword")
 	defer span.End()
 
-	return a.repo.ChangePassword(ctx, req)
+	hash, err := password.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	req.Password = hash
+
+	var resp *entity.ChangeAdminPasswordResp
+	err = a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if resp, err = a.repo.ChangePassword(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		return a.outbox.add(ctx, entity.EventAdminPasswordChanged, resp.Id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (a adminService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
@@ -115,5 +221,279 @@ func (a adminService) UpdateRefreshToken(ctx context.Context, req *entity.Update
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"UpdateRefreshToken")
 	defer span.End()
 
-	return a.repo.UpdateRefreshToken(ctx, req)
+	if req.RefreshToken == "" {
+		return nil, entity.NewErrNoRequiredParameter("refresh_token")
+	}
+
+	_, err := a.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: req.Id,
+	})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return &entity.UpdateRefreshTokenResp{Status: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.refreshTokens.issue(ctx, req.Id, req.RefreshToken, req.DeviceId); err != nil {
+		return nil, err
+	}
+
+	return &entity.UpdateRefreshTokenResp{Status: true}, nil
+}
+
+func (a adminService) RotateRefreshToken(ctx context.Context, req *entity.RotateRefreshTokenReq) (*entity.RotateRefreshTokenResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"RotateRefreshToken")
+	defer span.End()
+
+	resp, err := a.refreshTokens.rotate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ReuseDetected {
+		span.SetAttributes(attribute.Key("admin_id").String(resp.AccountId))
+		span.AddEvent("refresh token reuse detected, token family revoked")
+	}
+
+	return resp, nil
+}
+
+func (a adminService) VerifyCredentials(ctx context.Context, req *entity.VerifyCredentialsReq) (*entity.VerifyCredentialsResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"VerifyCredentials")
+	defer span.End()
+
+	lookup := entity.FieldValueReq{
+		Field: "email",
+		Value: req.Email,
+	}
+	if req.Email == "" {
+		lookup.Field, lookup.Value = "phone_number", req.PhoneNumber
+	}
+	if lookup.Value == "" {
+		return nil, entity.NewErrNoRequiredParameter("email", "phone_number")
+	}
+
+	identifier := lookup.Value
+	now := time.Now().UTC()
+
+	throttled, err := a.lockout.throttled(ctx, identifier, now)
+	if err != nil {
+		return nil, err
+	}
+	if throttled {
+		return lockedResp(time.Time{}), nil
+	}
+
+	creds, err := a.repo.GetCredentials(ctx, &lookup)
+	if errors.Is(err, entity.ErrorNotFound) {
+		password.CompareUnknown(req.Password)
+		if err := a.lockout.record(ctx, "", identifier, false, now); err != nil {
+			return nil, err
+		}
+		return &entity.VerifyCredentialsResp{Valid: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Key("admin_id").String(creds.Id))
+
+	if creds.LockedUntil.After(now) {
+		password.CompareUnknown(req.Password)
+		if err := a.lockout.record(ctx, creds.Id, identifier, false, now); err != nil {
+			return nil, err
+		}
+		return lockedResp(creds.LockedUntil), nil
+	}
+
+	valid, err := password.Compare(creds.Password, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.lockout.record(ctx, creds.Id, identifier, valid, now); err != nil {
+		return nil, err
+	}
+	if !valid {
+		lockedUntil, err := a.lockout.registerFailure(ctx, a.repo, creds.Id, now)
+		if err != nil {
+			return nil, err
+		}
+		if !lockedUntil.IsZero() {
+			return lockedResp(lockedUntil), nil
+		}
+		return &entity.VerifyCredentialsResp{Valid: false}, nil
+	}
+
+	if creds.FailedLoginAttempts > 0 || !creds.LockedUntil.IsZero() {
+		if _, err := a.repo.Unlock(ctx, &entity.UnlockAccountReq{Id: creds.Id}); err != nil {
+			return nil, err
+		}
+	}
+
+	// legacy rows still hold plaintext passwords, replace them now that we know the secret
+	if creds.PasswordRehash || password.NeedsRehash(creds.Password) {
+		hash, err := password.Hash(req.Password)
+		if err == nil {
+			err = a.repo.RehashPassword(ctx, &entity.RehashPasswordReq{
+				Id:       creds.Id,
+				Password: hash,
+			})
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	return &entity.VerifyCredentialsResp{
+		Valid:  true,
+		Id:     creds.Id,
+		Role:   creds.Role,
+		Status: entity.AccountStatusActive,
+	}, nil
+}
+
+func (a adminService) Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Unlock")
+	defer span.End()
+
+	var resp *entity.UnlockAccountResp
+	err := a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if resp, err = a.repo.Unlock(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		creds, err := a.repo.GetCredentials(ctx, &entity.FieldValueReq{
+			Field: "id",
+			Value: req.Id,
+		})
+		if err != nil {
+			return err
+		}
+		return a.lockout.clear(ctx, creds.PhoneNumber, creds.Email)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (a adminService) ChangeRole(ctx context.Context, req *entity.ChangeAdminRoleReq) (*entity.ChangeAdminRoleResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ChangeRole")
+	defer span.End()
+
+	if req.Id == "" || req.Role == "" {
+		return nil, entity.NewErrNoRequiredParameter("id", "role")
+	}
+
+	var resp *entity.ChangeAdminRoleResp
+	err := a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		admin, err := a.repo.Get(ctx, &entity.FieldValueReq{
+			Field: "id",
+			Value: req.Id,
+		})
+		if err != nil {
+			return err
+		}
+
+		allowed := false
+		for _, role := range roleTransitions[admin.Role] {
+			if role == req.Role {
+				allowed = true
+			}
+		}
+		if !allowed {
+			return entity.NewErrFailedPrecondition(fmt.Sprintf("role cannot be changed from %s to %s", admin.Role, req.Role))
+		}
+
+		if admin.Role == entity.RoleSuperadmin {
+			if err := a.ensureSuperadminRemains(ctx, &entity.FieldValueReq{Field: "id", Value: req.Id}); err != nil {
+				return err
+			}
+		}
+
+		resp, err = a.repo.ChangeRole(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ensureSuperadminRemains must run inside a transaction, it locks the active superadmins and
+// rejects the change when every one of them matches req
+func (a adminService) ensureSuperadminRemains(ctx context.Context, req *entity.FieldValueReq) error {
+	count, err := a.repo.CountActiveSuperadmins(ctx, req)
+	if err != nil {
+		return err
+	}
+	if count.Matched > 0 && count.Total-count.Matched < 1 {
+		return entity.ErrorLastSuperadmin
+	}
+
+	return nil
+}
+
+// workPeriodEnded matches the repository's notion of an active admin, the period is over once
+// the end date is today or earlier, an empty end date means the admin is still working
+func workPeriodEnded(endWorkYear string, now time.Time) bool {
+	if endWorkYear == "" {
+		return false
+	}
+	end, err := time.Parse("2006-01-02", endWorkYear)
+	if err != nil {
+		// let the superadmin check run rather than skip it on an unexpected format
+		return true
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return !end.After(today)
+}
+
+func (a adminService) Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Restore")
+	defer span.End()
+
+	var resp *entity.RestoreAccountResp
+	err := a.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if resp, err = a.repo.Restore(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		return a.outbox.add(ctx, entity.EventAdminRestored, req.Id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// addAdminEvent records an event carrying the admin as stored by the transaction in ctx
+func (a adminService) addAdminEvent(ctx context.Context, eventType, id string) error {
+	admin, err := a.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+	if err != nil {
+		return err
+	}
+	return a.outbox.add(ctx, eventType, id, newAdminEventPayload(admin))
 }
@@ -0,0 +1,223 @@
+package event
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/backoff"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+// Delivery is the part of consuming every broker shares. It retries a failing handler with
+// backoff, moves a message that keeps failing to the dead letter topic, and closes in two steps:
+// fetching and retrying stop first, handling still in progress is cancelled only when Close gives up.
+type Delivery struct {
+	logger *zap.Logger
+	// maxAttempts bounds the handler calls per message, retries wait for backoff in between
+	maxAttempts      int
+	backoff          backoff.Exponential
+	deadLetterSuffix string
+	workers          int
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	handling context.Context
+	abort    context.CancelFunc
+	running  sync.WaitGroup
+}
+
+// ConsumedMessage is a message read from a topic, described independently of its broker
+type ConsumedMessage struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Group     string
+	Key       []byte
+	Value     []byte
+}
+
+// DeadLetter is written to Topic with the key, value and headers of the original message, Headers
+// are added to the original ones
+type DeadLetter struct {
+	Topic   string
+	Headers []Header
+}
+
+type Header struct {
+	Key   string
+	Value string
+}
+
+// NewDelivery reads the consumer settings shared by the brokers
+func NewDelivery(config *config.Config, logger *zap.Logger) (*Delivery, error) {
+	maxRetries, err := strconv.Atoi(config.Kafka.Consumer.MaxRetries)
+	if err != nil {
+		return nil, fmt.Errorf("error during parse kafka consumer max retries : %w", err)
+	}
+	if maxRetries < 0 {
+		return nil, fmt.Errorf("kafka consumer max retries must not be negative")
+	}
+	workers, err := strconv.Atoi(config.Kafka.Consumer.Workers)
+	if err != nil {
+		return nil, fmt.Errorf("error during parse kafka consumer workers : %w", err)
+	}
+	if workers < 1 {
+		return nil, fmt.Errorf("kafka consumer workers must be positive")
+	}
+	backoffBase, err := time.ParseDuration(config.Kafka.Consumer.BackoffBase)
+	if err != nil {
+		return nil, fmt.Errorf("error during parse duration for kafka consumer backoff base : %w", err)
+	}
+	backoffMax, err := time.ParseDuration(config.Kafka.Consumer.BackoffMax)
+	if err != nil {
+		return nil, fmt.Errorf("error during parse duration for kafka consumer backoff max : %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handling, abort := context.WithCancel(context.Background())
+	return &Delivery{
+		logger:      logger,
+		maxAttempts: maxRetries + 1,
+		backoff: backoff.Exponential{
+			Base:   backoffBase,
+			Max:    backoffMax,
+			Jitter: 0.2,
+		},
+		deadLetterSuffix: config.Kafka.Consumer.DeadLetterSuffix,
+		workers:          workers,
+		ctx:              ctx,
+		cancel:           cancel,
+		handling:         handling,
+		abort:            abort,
+	}, nil
+}
+
+// Go runs fn in a goroutine Close waits for
+func (d *Delivery) Go(fn func()) {
+	d.running.Add(1)
+	go func() {
+		defer d.running.Done()
+		fn()
+	}()
+}
+
+// Stopping is done once Close is called, brokers stop fetching then
+func (d *Delivery) Stopping() context.Context {
+	return d.ctx
+}
+
+// Handling is the context messages are handled and committed with
+func (d *Delivery) Handling() context.Context {
+	return d.handling
+}
+
+// Workers is the number of messages of one topic handled at a time
+func (d *Delivery) Workers() int {
+	return d.workers
+}
+
+// Wait sleeps for the backoff of attempt and reports false when Close is called in the meantime
+func (d *Delivery) Wait(attempt int) bool {
+	timer := time.NewTimer(d.backoff.Delay(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-d.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Close stops fetching and waits for the messages in progress, once ctx is done they are cancelled
+func (d *Delivery) Close(ctx context.Context) error {
+	d.cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		d.running.Wait()
+		close(stopped)
+	}()
+
+	var err error
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		d.abort()
+		<-stopped
+		err = fmt.Errorf("consumer stopped before messages in progress were handled: %w", ctx.Err())
+	}
+	d.abort()
+	return err
+}
+
+// Handle calls the handler until it succeeds or runs out of attempts, then writes the message to
+// the dead letter topic until writeDeadLetter accepts it, skipping it would lose the message. ctx
+// carries span, the span of the message. Handle reports false when the consumer was closed before
+// the message was settled, the message must not be committed then.
+func (d *Delivery) Handle(
+	ctx context.Context,
+	span otlp.Span,
+	m *ConsumedMessage,
+	handler func(ctx context.Context, key, value []byte) error,
+	writeDeadLetter func(ctx context.Context, deadLetter *DeadLetter) error,
+) bool {
+	var err error
+	for attempt := 0; attempt < d.maxAttempts; attempt++ {
+		if attempt > 0 && !d.Wait(attempt-1) {
+			return false
+		}
+		span.SetAttributes(attribute.Int("messaging.attempts", attempt+1))
+		if err = handler(ctx, m.Key, m.Value); err == nil {
+			return true
+		}
+		span.Error(err)
+		// the value is never logged, messages such as api.user.create carry passwords
+		d.logger.Error("consumer failed to handler message:",
+			zap.String("topic", m.Topic),
+			zap.Int("partition", m.Partition),
+			zap.Int64("offset", m.Offset),
+			zap.ByteString("key", m.Key),
+			zap.Int("attempt", attempt+1),
+			zap.Error(err),
+		)
+	}
+
+	deadLetter := d.deadLetter(m, err)
+	for attempt := 0; ; attempt++ {
+		dltErr := writeDeadLetter(d.handling, deadLetter)
+		if dltErr == nil {
+			d.logger.Warn("consumer moved message to dead letter topic",
+				zap.String("topic", m.Topic),
+				zap.String("dead_letter_topic", deadLetter.Topic),
+				zap.Int64("offset", m.Offset),
+			)
+			return true
+		}
+		d.logger.Error("consumer failed to write dead letter message", zap.String("topic", deadLetter.Topic), zap.Error(dltErr))
+		if !d.Wait(attempt) {
+			return false
+		}
+	}
+}
+
+func (d *Delivery) deadLetter(m *ConsumedMessage, err error) *DeadLetter {
+	return &DeadLetter{
+		Topic: m.Topic + d.deadLetterSuffix,
+		Headers: []Header{
+			{Key: HeaderDeadLetterTopic, Value: m.Topic},
+			{Key: HeaderDeadLetterPartition, Value: strconv.Itoa(m.Partition)},
+			{Key: HeaderDeadLetterOffset, Value: strconv.FormatInt(m.Offset, 10)},
+			{Key: HeaderDeadLetterGroup, Value: m.Group},
+			{Key: HeaderDeadLetterError, Value: err.Error()},
+			{Key: HeaderDeadLetterAttempts, Value: strconv.Itoa(d.maxAttempts)},
+			{Key: HeaderDeadLetterFailedAt, Value: time.Now().UTC().Format(time.RFC3339Nano)},
+		},
+	}
+}
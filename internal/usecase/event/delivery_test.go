@@ -0,0 +1,178 @@
+package event
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type DeliveryTestSuite struct {
+	suite.Suite
+	delivery *Delivery
+}
+
+func (s *DeliveryTestSuite) SetupTest() {
+	cfg := config.New()
+	cfg.Kafka.Consumer.MaxRetries = "2"
+	cfg.Kafka.Consumer.BackoffBase = "1ms"
+	cfg.Kafka.Consumer.BackoffMax = "5ms"
+
+	var err error
+	s.delivery, err = NewDelivery(cfg, zap.NewNop())
+	s.Suite.NoError(err)
+}
+
+func (s *DeliveryTestSuite) TearDownTest() {
+	s.Suite.NoError(s.delivery.Close(context.Background()))
+}
+
+// handle delivers m with handler, dead letters are collected into deadLetters
+func (s *DeliveryTestSuite) handle(m *ConsumedMessage, handler func(ctx context.Context, key, value []byte) error, deadLetters *[]*DeadLetter) bool {
+	ctx, span := otlp.Start(s.delivery.Handling(), "test", "handle")
+	defer span.End()
+
+	return s.delivery.Handle(ctx, span, m, handler, func(ctx context.Context, deadLetter *DeadLetter) error {
+		*deadLetters = append(*deadLetters, deadLetter)
+		return nil
+	})
+}
+
+// test func
+func (s *DeliveryTestSuite) TestRetry() {
+	var (
+		calls       int
+		deadLetters []*DeadLetter
+	)
+	handled := s.handle(&ConsumedMessage{Topic: "api.user.create"}, func(ctx context.Context, key, value []byte) error {
+		calls++
+		if calls < 3 {
+			return errors.New("database is down")
+		}
+		return nil
+	}, &deadLetters)
+
+	s.Suite.True(handled)
+	s.Suite.Equal(3, calls)
+	s.Suite.Empty(deadLetters)
+}
+
+func (s *DeliveryTestSuite) TestDeadLetter() {
+	var (
+		calls       int
+		deadLetters []*DeadLetter
+	)
+	handled := s.handle(&ConsumedMessage{
+		Topic:     "api.user.create",
+		Partition: 2,
+		Offset:    42,
+		Group:     "1",
+		Key:       []byte("key"),
+		Value:     []byte("value"),
+	}, func(ctx context.Context, key, value []byte) error {
+		calls++
+		return errors.New("invalid payload")
+	}, &deadLetters)
+
+	s.Suite.True(handled)
+	s.Suite.Equal(3, calls)
+	s.Suite.Len(deadLetters, 1)
+	s.Suite.Equal("api.user.create.dlt", deadLetters[0].Topic)
+
+	headers := make(map[string]string)
+	for _, header := range deadLetters[0].Headers {
+		headers[header.Key] = header.Value
+	}
+	s.Suite.Equal("api.user.create", headers[HeaderDeadLetterTopic])
+	s.Suite.Equal("2", headers[HeaderDeadLetterPartition])
+	s.Suite.Equal("42", headers[HeaderDeadLetterOffset])
+	s.Suite.Equal("1", headers[HeaderDeadLetterGroup])
+	s.Suite.Equal("invalid payload", headers[HeaderDeadLetterError])
+	s.Suite.Equal("3", headers[HeaderDeadLetterAttempts])
+	_, err := time.Parse(time.RFC3339Nano, headers[HeaderDeadLetterFailedAt])
+	s.Suite.NoError(err)
+}
+
+func (s *DeliveryTestSuite) TestFailureLogOmitsValue() {
+	core, logs := observer.New(zap.ErrorLevel)
+	s.delivery.logger = zap.New(core)
+
+	var deadLetters []*DeadLetter
+	s.handle(&ConsumedMessage{
+		Topic: "api.user.create",
+		Key:   []byte("key"),
+		Value: []byte(`{"Password":"secret"}`),
+	}, func(ctx context.Context, key, value []byte) error {
+		return errors.New("database is down")
+	}, &deadLetters)
+
+	// every attempt is logged without the message, it may carry a password
+	failures := logs.FilterMessage("consumer failed to handler message:").All()
+	s.Suite.Len(failures, 3)
+	for _, failure := range failures {
+		fields := failure.ContextMap()
+		s.Suite.NotContains(fields, "value")
+		s.Suite.Equal("key", fields["key"])
+	}
+}
+
+func (s *DeliveryTestSuite) TestClosedWhileRetrying() {
+	var deadLetters []*DeadLetter
+	s.Suite.NoError(s.delivery.Close(context.Background()))
+
+	handled := s.handle(&ConsumedMessage{Topic: "api.user.create"}, func(ctx context.Context, key, value []byte) error {
+		return errors.New("database is down")
+	}, &deadLetters)
+	s.Suite.False(handled)
+	s.Suite.Empty(deadLetters)
+}
+
+func (s *DeliveryTestSuite) TestCloseFinishesMessage() {
+	var (
+		deadLetters []*DeadLetter
+		handling    = make(chan struct{})
+		handled     = make(chan bool, 1)
+	)
+	s.delivery.Go(func() {
+		handled <- s.handle(&ConsumedMessage{Topic: "api.user.create"}, func(ctx context.Context, key, value []byte) error {
+			close(handling)
+			// the handler keeps its context while the consumer closes
+			time.Sleep(10 * time.Millisecond)
+			return ctx.Err()
+		}, &deadLetters)
+	})
+	<-handling
+
+	s.Suite.NoError(s.delivery.Close(context.Background()))
+	s.Suite.True(<-handled)
+}
+
+func (s *DeliveryTestSuite) TestCloseGivesUp() {
+	var (
+		deadLetters []*DeadLetter
+		handling    = make(chan struct{})
+	)
+	s.delivery.Go(func() {
+		s.handle(&ConsumedMessage{Topic: "api.user.create"}, func(ctx context.Context, key, value []byte) error {
+			close(handling)
+			<-ctx.Done()
+			return ctx.Err()
+		}, &deadLetters)
+	})
+	<-handling
+
+	// a message still in progress when Close stops waiting is cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.Suite.Error(s.delivery.Close(ctx))
+}
+
+func TestDeliveryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryTestSuite))
+}
@@ -15,10 +15,12 @@ type ConsumerConfig interface {
 type BrokerConsumer interface {
 	Run()
 	RegisterConsumer(config ConsumerConfig)
-	Close()
+	// Close stops consuming and waits for the messages in progress to be handled and committed,
+	// once ctx is done they are cancelled and left uncommitted
+	Close(ctx context.Context) error
 }
 
 type BrokerProducer interface {
-	ProduceContent(ctx context.Context, key string, value *entity.User) error
+	ProduceEvent(ctx context.Context, event *entity.Event) error
 	Close()
 }
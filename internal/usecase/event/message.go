@@ -0,0 +1,56 @@
+package event
+
+import (
+	"dennic_user_service/internal/entity"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// headers every broker writes next to the event message
+	HeaderEventType     = "event_type"
+	HeaderSchemaVersion = "schema_version"
+
+	// headers added to a message moved to the dead letter topic, next to its original headers
+	HeaderDeadLetterTopic     = "dlt.original_topic"
+	HeaderDeadLetterPartition = "dlt.original_partition"
+	HeaderDeadLetterOffset    = "dlt.original_offset"
+	HeaderDeadLetterGroup     = "dlt.consumer_group"
+	HeaderDeadLetterError     = "dlt.error"
+	HeaderDeadLetterAttempts  = "dlt.attempts"
+	HeaderDeadLetterFailedAt  = "dlt.failed_at"
+)
+
+// Message is the versioned JSON envelope of every account event, consumers
+// deduplicate by Id and branch on Type and Version before reading the payload.
+// Id is kept when the outbox relays an event again.
+type Message struct {
+	Id          string    `json:"id"`
+	Type        string    `json:"type"`
+	Version     int       `json:"version"`
+	AggregateId string    `json:"aggregate_id"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	Payload     any       `json:"payload,omitempty"`
+}
+
+// MarshalEvent encodes the event in its envelope, an event without an id gets a new one
+func MarshalEvent(e *entity.Event) ([]byte, error) {
+	if e.Id == "" {
+		e.Id = uuid.NewString()
+	}
+	value, err := json.Marshal(Message{
+		Id:          e.Id,
+		Type:        e.Type,
+		Version:     entity.EventSchemaVersion,
+		AggregateId: e.AggregateId,
+		OccurredAt:  e.OccurredAt,
+		Payload:     e.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s event: %w", e.Type, err)
+	}
+	return value, nil
+}
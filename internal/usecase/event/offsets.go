@@ -0,0 +1,58 @@
+package event
+
+import (
+	"sync"
+)
+
+// OffsetTracker follows the messages of a topic handled out of order, it lets a partition be
+// committed only up to the last message before the first one still in progress
+type OffsetTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	// pending holds the fetched offsets not committable yet in fetch order, handled the ones done
+	pending []int64
+	handled map[int64]bool
+}
+
+func NewOffsetTracker() *OffsetTracker {
+	return &OffsetTracker{
+		partitions: make(map[int]*partitionOffsets),
+	}
+}
+
+// Start records a fetched message, messages of a partition must be started in fetch order
+func (t *OffsetTracker) Start(partition int, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	offsets, ok := t.partitions[partition]
+	if !ok {
+		offsets = &partitionOffsets{handled: make(map[int64]bool)}
+		t.partitions[partition] = offsets
+	}
+	offsets.pending = append(offsets.pending, offset)
+}
+
+// Finish records a handled message. It returns the offset to commit the partition up to when the
+// messages handled without a gap from the oldest pending one grew, ok is false otherwise.
+func (t *OffsetTracker) Finish(partition int, offset int64) (commit int64, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	offsets, found := t.partitions[partition]
+	if !found {
+		return 0, false
+	}
+	offsets.handled[offset] = true
+
+	for len(offsets.pending) > 0 && offsets.handled[offsets.pending[0]] {
+		commit = offsets.pending[0]
+		delete(offsets.handled, commit)
+		offsets.pending = offsets.pending[1:]
+		ok = true
+	}
+	return commit, ok
+}
@@ -0,0 +1,50 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OffsetTrackerTestSuite struct {
+	suite.Suite
+	tracker *OffsetTracker
+}
+
+func (s *OffsetTrackerTestSuite) SetupTest() {
+	s.tracker = NewOffsetTracker()
+}
+
+func (s *OffsetTrackerTestSuite) TestContiguousCommit() {
+	s.tracker.Start(0, 10)
+	s.tracker.Start(0, 11)
+	// offsets of a partition may have gaps, the fetch order counts
+	s.tracker.Start(0, 14)
+	s.tracker.Start(1, 3)
+
+	// a later message is not committed while an earlier one is in progress
+	_, ok := s.tracker.Finish(0, 11)
+	s.Suite.False(ok)
+
+	commit, ok := s.tracker.Finish(0, 10)
+	s.Suite.True(ok)
+	s.Suite.Equal(int64(11), commit)
+
+	// partitions are committed independently
+	commit, ok = s.tracker.Finish(1, 3)
+	s.Suite.True(ok)
+	s.Suite.Equal(int64(3), commit)
+
+	commit, ok = s.tracker.Finish(0, 14)
+	s.Suite.True(ok)
+	s.Suite.Equal(int64(14), commit)
+}
+
+func (s *OffsetTrackerTestSuite) TestUnknownMessage() {
+	_, ok := s.tracker.Finish(2, 1)
+	s.Suite.False(ok)
+}
+
+func TestOffsetTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(OffsetTrackerTestSuite))
+}
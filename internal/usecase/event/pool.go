@@ -0,0 +1,56 @@
+package event
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// poolQueueSize is the number of messages waiting for each worker before submitting blocks
+const poolQueueSize = 16
+
+// KeyedPool handles messages on a fixed set of workers. Messages with the same key go to the
+// same worker and are handled in the order submitted.
+type KeyedPool[M any] struct {
+	queues  []chan M
+	key     func(m M) []byte
+	running sync.WaitGroup
+}
+
+func NewKeyedPool[M any](workers int, key func(m M) []byte, handle func(m M)) *KeyedPool[M] {
+	p := &KeyedPool[M]{
+		queues: make([]chan M, workers),
+		key:    key,
+	}
+	for i := range p.queues {
+		queue := make(chan M, poolQueueSize)
+		p.queues[i] = queue
+
+		p.running.Add(1)
+		go func() {
+			defer p.running.Done()
+			for m := range queue {
+				handle(m)
+			}
+		}()
+	}
+	return p
+}
+
+// Submit queues the message for the worker of its key, it blocks while that worker's queue is full
+func (p *KeyedPool[M]) Submit(m M) {
+	p.queues[p.worker(m)] <- m
+}
+
+func (p *KeyedPool[M]) worker(m M) int {
+	h := fnv.New32a()
+	h.Write(p.key(m))
+	return int(h.Sum32() % uint32(len(p.queues)))
+}
+
+// Close waits for the workers to handle the queued messages, nothing can be submitted afterwards
+func (p *KeyedPool[M]) Close() {
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.running.Wait()
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type KeyedPoolTestSuite struct {
+	suite.Suite
+}
+
+func messageKey(m *ConsumedMessage) []byte {
+	return m.Key
+}
+
+func (s *KeyedPoolTestSuite) TestOrderPerKey() {
+	var (
+		mu      sync.Mutex
+		handled = make(map[string][]int64)
+	)
+	pool := NewKeyedPool(4, messageKey, func(m *ConsumedMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		handled[string(m.Key)] = append(handled[string(m.Key)], m.Offset)
+	})
+
+	keys := []string{"a", "b", "c", "d", "e"}
+	for offset := int64(0); offset < 100; offset++ {
+		pool.Submit(&ConsumedMessage{Key: []byte(keys[offset%int64(len(keys))]), Offset: offset})
+	}
+	pool.Close()
+
+	for i, key := range keys {
+		s.Suite.Len(handled[key], 20)
+		for n, offset := range handled[key] {
+			s.Suite.Equal(int64(i+n*len(keys)), offset, "key "+key+" message "+strconv.Itoa(n))
+		}
+	}
+}
+
+func (s *KeyedPoolTestSuite) TestSameWorkerPerKey() {
+	pool := NewKeyedPool(8, messageKey, func(m *ConsumedMessage) {})
+	defer pool.Close()
+
+	s.Suite.Equal(pool.worker(&ConsumedMessage{Key: []byte("user-1"), Partition: 0}), pool.worker(&ConsumedMessage{Key: []byte("user-1"), Partition: 5}))
+}
+
+func TestKeyedPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyedPoolTestSuite))
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/minio"
+	"dennic_user_service/internal/pkg/otlp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// userEventPayload is the user snapshot of user.created and user.updated, passwords and tokens are never published
+type userEventPayload struct {
+	Id          string     `json:"id"`
+	UserOrder   uint64     `json:"user_order"`
+	FirstName   string     `json:"first_name"`
+	LastName    string     `json:"last_name"`
+	BirthDate   string     `json:"birth_date"`
+	PhoneNumber string     `json:"phone_number"`
+	Gender      string     `json:"gender"`
+	ImageUrl    string     `json:"image_url,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// adminEventPayload is the admin snapshot of admin.created and admin.updated
+type adminEventPayload struct {
+	Id            string     `json:"id"`
+	AdminOrder    int64      `json:"admin_order"`
+	Role          string     `json:"role"`
+	FirstName     string     `json:"first_name"`
+	LastName      string     `json:"last_name"`
+	BirthDate     string     `json:"birth_date"`
+	PhoneNumber   string     `json:"phone_number"`
+	Email         string     `json:"email"`
+	Gender        string     `json:"gender"`
+	StartWorkYear string     `json:"start_work_year"`
+	EndWorkYear   string     `json:"end_work_year,omitempty"`
+	ImageUrl      string     `json:"image_url,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+// deletedEventPayload tells consumers whether the account row still exists
+type deletedEventPayload struct {
+	Mode string `json:"mode"`
+}
+
+// anonymizedEventPayload keeps the order number other services may show in place of the name
+type anonymizedEventPayload struct {
+	UserOrder uint64 `json:"user_order"`
+}
+
+func newUserEventPayload(user *entity.User) userEventPayload {
+	return userEventPayload{
+		Id:          user.Id,
+		UserOrder:   user.UserOrder,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		BirthDate:   user.BirthDate,
+		PhoneNumber: user.PhoneNumber,
+		Gender:      user.Gender,
+		ImageUrl:    minio.UserImageUrl(user.ImageUrl),
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   optionalTime(user.UpdatedAt),
+	}
+}
+
+func newAdminEventPayload(admin *entity.Admin) adminEventPayload {
+	return adminEventPayload{
+		Id:            admin.Id,
+		AdminOrder:    admin.AdminOrder,
+		Role:          admin.Role,
+		FirstName:     admin.FirstName,
+		LastName:      admin.LastName,
+		BirthDate:     admin.BirthDate,
+		PhoneNumber:   admin.PhoneNumber,
+		Email:         admin.Email,
+		Gender:        admin.Gender,
+		StartWorkYear: admin.StartWorkYear,
+		EndWorkYear:   admin.EndWorkYear,
+		ImageUrl:      minio.UserImageUrl(admin.ImageUrl),
+		CreatedAt:     admin.CreatedAt,
+		UpdatedAt:     optionalTime(admin.UpdatedAt),
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// outbox records the events of a change, called with the context of the transaction making the change
+type outbox struct {
+	repo repository.OutboxStorageI
+}
+
+func (o outbox) add(ctx context.Context, eventType, aggregateId string, payload any) error {
+	return o.repo.Add(ctx, &entity.Event{
+		Id:           uuid.NewString(),
+		Type:         eventType,
+		AggregateId:  aggregateId,
+		OccurredAt:   time.Now().UTC(),
+		Payload:      payload,
+		TraceContext: otlp.Inject(ctx),
+	})
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"time"
+)
+
+const (
+	// lockoutThreshold is the number of consecutive failures after which an account is locked
+	lockoutThreshold = 5
+	// lockoutBaseWindow is the first lock window, every further failure doubles it
+	lockoutBaseWindow = time.Minute
+	lockoutMaxWindow  = 24 * time.Hour
+
+	// identifierFailureLimit caps failures per phone number or email regardless of the account,
+	// so guessing against unknown identifiers is throttled as well
+	identifierFailureLimit  = 20
+	identifierFailureWindow = 15 * time.Minute
+)
+
+type lockableStorageI interface {
+	RegisterFailedLogin(ctx context.Context, id string) (int64, error)
+	Lock(ctx context.Context, req *entity.LockAccountReq) error
+}
+
+type lockout struct {
+	accountType string
+	attempts    repository.LoginAttemptStorageI
+}
+
+// lockoutWindow returns how long an account stays locked after the given number of consecutive failures
+func lockoutWindow(failed int64) time.Duration {
+	if failed < lockoutThreshold {
+		return 0
+	}
+
+	window := lockoutBaseWindow
+	for i := int64(lockoutThreshold); i < failed; i++ {
+		window *= 2
+		if window >= lockoutMaxWindow {
+			return lockoutMaxWindow
+		}
+	}
+
+	return window
+}
+
+// throttled reports whether the identifier has too many recent failures to be checked at all
+func (l lockout) throttled(ctx context.Context, identifier string, now time.Time) (bool, error) {
+	failed, err := l.attempts.CountFailed(ctx, &entity.CountLoginAttemptsReq{
+		AccountType: l.accountType,
+		Identifier:  identifier,
+		Since:       now.Add(-identifierFailureWindow),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return failed >= identifierFailureLimit, nil
+}
+
+// clear lifts the identifier throttle, so an unlocked account can log in right away
+func (l lockout) clear(ctx context.Context, identifiers ...string) error {
+	var nonEmpty []string
+	for _, identifier := range identifiers {
+		if identifier != "" {
+			nonEmpty = append(nonEmpty, identifier)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return nil
+	}
+
+	return l.attempts.ClearFailed(ctx, &entity.ClearLoginAttemptsReq{
+		AccountType: l.accountType,
+		Identifiers: nonEmpty,
+	})
+}
+
+func (l lockout) record(ctx context.Context, accountId, identifier string, success bool, now time.Time) error {
+	return l.attempts.Create(ctx, &entity.LoginAttempt{
+		AccountType: l.accountType,
+		AccountId:   accountId,
+		Identifier:  identifier,
+		Success:     success,
+		CreatedAt:   now,
+	})
+}
+
+// registerFailure bumps the account failure counter and locks the account once the threshold is reached.
+// The returned time is zero when the account was not locked.
+func (l lockout) registerFailure(ctx context.Context, repo lockableStorageI, id string, now time.Time) (time.Time, error) {
+	failed, err := repo.RegisterFailedLogin(ctx, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	window := lockoutWindow(failed)
+	if window == 0 {
+		return time.Time{}, nil
+	}
+
+	lockedUntil := now.Add(window)
+	if err := repo.Lock(ctx, &entity.LockAccountReq{
+		Id:          id,
+		LockedUntil: lockedUntil,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	return lockedUntil, nil
+}
+
+func lockedResp(lockedUntil time.Time) *entity.VerifyCredentialsResp {
+	return &entity.VerifyCredentialsResp{
+		Valid:       false,
+		Status:      entity.AccountStatusLocked,
+		LockedUntil: lockedUntil,
+	}
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/backoff"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/usecase/event"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	OutboxServiceName = "outboxService"
+	OutboxSpanName    = "outboxUsecase"
+)
+
+type OutboxStorageI interface {
+	Relay(ctx context.Context) (*entity.RelayResp, error)
+}
+
+type outboxService struct {
+	repo       repository.OutboxStorageI
+	transactor repository.Transactor
+	producer   event.BrokerProducer
+	backoff    backoff.Exponential
+	ctxTimeout time.Duration
+	batchSize  uint64
+}
+
+func NewOutboxService(ctxTimeout time.Duration, repo repository.OutboxStorageI, transactor repository.Transactor, producer event.BrokerProducer, backoff backoff.Exponential, batchSize uint64) outboxService {
+	return outboxService{
+		repo:       repo,
+		transactor: transactor,
+		producer:   producer,
+		backoff:    backoff,
+		ctxTimeout: ctxTimeout,
+		batchSize:  batchSize,
+	}
+}
+
+// Relay drains the due outbox events to the broker in the order they were written. An event is
+// removed only after the broker accepted it, so consumers may see it twice but never miss it.
+// A replica that finds another one claiming a batch leaves the outbox to it until the next tick.
+func (o outboxService) Relay(ctx context.Context) (*entity.RelayResp, error) {
+	ctx, span := otlp.Start(ctx, OutboxServiceName, OutboxSpanName+"Relay")
+	defer span.End()
+
+	var resp entity.RelayResp
+	for !resp.Skipped {
+		relayed, err := o.relayBatch(ctx, &resp)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(relayed) < o.batchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int64("published", resp.Published),
+		attribute.Int64("failed", resp.Failed),
+		attribute.Bool("skipped", resp.Skipped),
+	)
+	return &resp, nil
+}
+
+// relayBatch publishes one batch and reports how many events it picked up. The batch is claimed
+// in a short transaction under the relay lock and published after that transaction commits, so
+// neither the lock nor a connection is held while the broker is slow. The outcome is written
+// back in a second short transaction.
+func (o outboxService) relayBatch(ctx context.Context, resp *entity.RelayResp) (int, error) {
+	messages, claimedUntil, err := o.claimBatch(ctx, resp)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	// publishing stops once the claim runs out, the rest is picked up by the next run
+	publishCtx, cancel := context.WithDeadline(ctx, claimedUntil)
+	defer cancel()
+
+	var (
+		published []string
+		failed    []*entity.OutboxFailureReq
+		// once an event of an account fails, its later events wait for it
+		blocked = make(map[string]bool)
+	)
+	for _, message := range messages {
+		if blocked[message.AggregateId] {
+			continue
+		}
+		if publishCtx.Err() != nil {
+			break
+		}
+
+		if err := o.producer.ProduceEvent(publishCtx, &message.Event); err != nil {
+			blocked[message.AggregateId] = true
+			failed = append(failed, &entity.OutboxFailureReq{
+				Id:            message.Id,
+				NextAttemptAt: time.Now().UTC().Add(o.backoff.Delay(message.Attempts)),
+				Error:         err.Error(),
+			})
+			continue
+		}
+		published = append(published, message.Id)
+	}
+
+	ctx, cancel = context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	err = o.transactor.WithTx(ctx, func(ctx context.Context) error {
+		for _, id := range published {
+			if err := o.repo.Delete(ctx, id); err != nil {
+				return err
+			}
+		}
+		for _, req := range failed {
+			if err := o.repo.Postpone(ctx, req); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	resp.Published += int64(len(published))
+	resp.Failed += int64(len(failed))
+	return len(messages), nil
+}
+
+// claimBatch takes the due events and keeps other relays away from them for one context timeout
+func (o outboxService) claimBatch(ctx context.Context, resp *entity.RelayResp) ([]*entity.OutboxMessage, time.Time, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.ctxTimeout)
+	defer cancel()
+
+	var (
+		messages []*entity.OutboxMessage
+		now      = time.Now().UTC()
+		until    = now.Add(o.ctxTimeout)
+	)
+	err := o.transactor.WithTx(ctx, func(ctx context.Context) error {
+		locked, err := o.repo.TryLock(ctx)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			resp.Skipped = true
+			return nil
+		}
+
+		if messages, err = o.repo.Pending(ctx, &entity.PendingOutboxReq{
+			Now:   now,
+			Limit: o.batchSize,
+		}); err != nil || len(messages) == 0 {
+			return err
+		}
+
+		ids := make([]string, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.Id)
+		}
+		return o.repo.Claim(ctx, &entity.ClaimOutboxReq{
+			Ids:   ids,
+			Until: until,
+		})
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return messages, until, nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	PurgeServiceName = "purgeService"
+	PurgeSpanName    = "purgeUsecase"
+)
+
+type PurgeStorageI interface {
+	Purge(ctx context.Context) (*entity.PurgeResp, error)
+}
+
+type purgeService struct {
+	repo       repository.PurgeStorageI
+	outbox     outbox
+	images     repository.ImageStorageI
+	transactor repository.Transactor
+	ctxTimeout time.Duration
+	retention  time.Duration
+	batchSize  uint64
+	mode       string
+}
+
+func NewPurgeService(ctxTimeout time.Duration, repo repository.PurgeStorageI, outboxRepo repository.OutboxStorageI, images repository.ImageStorageI, transactor repository.Transactor, retention time.Duration, batchSize uint64, mode string) purgeService {
+	return purgeService{
+		repo:       repo,
+		outbox:     outbox{repo: outboxRepo},
+		images:     images,
+		transactor: transactor,
+		ctxTimeout: ctxTimeout,
+		retention:  retention,
+		batchSize:  batchSize,
+		mode:       mode,
+	}
+}
+
+// Purge removes or anonymizes every account soft deleted longer than the retention ago, together
+// with its image. Anonymized users are announced like the ones erased through the RPC. Each batch
+// runs in its own transaction under the purge lock, the run stops as soon as another replica holds
+// the lock.
+func (p purgeService) Purge(ctx context.Context) (*entity.PurgeResp, error) {
+	ctx, span := otlp.Start(ctx, PurgeServiceName, PurgeSpanName+"Purge")
+	defer span.End()
+
+	var (
+		resp          entity.PurgeResp
+		now           = time.Now().Add(time.Hour * 5)
+		deletedBefore = now.Add(-p.retention)
+	)
+	for _, target := range []struct {
+		accountType string
+		purged      *int64
+	}{
+		{entity.AccountTypeUser, &resp.Users},
+		{entity.AccountTypeAdmin, &resp.Admins},
+	} {
+		for !resp.Skipped {
+			purged, err := p.purgeBatch(ctx, &resp, &entity.PurgeBatchReq{
+				AccountType:   target.accountType,
+				DeletedBefore: deletedBefore,
+				Limit:         p.batchSize,
+				Mode:          p.mode,
+				PurgedAt:      now,
+			})
+			if err != nil {
+				return nil, err
+			}
+			*target.purged += purged
+			if uint64(purged) < p.batchSize {
+				break
+			}
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int64("purged_users", resp.Users),
+		attribute.Int64("purged_admins", resp.Admins),
+		attribute.Bool("skipped", resp.Skipped),
+	)
+	return &resp, nil
+}
+
+func (p purgeService) purgeBatch(ctx context.Context, resp *entity.PurgeResp, req *entity.PurgeBatchReq) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.ctxTimeout)
+	defer cancel()
+
+	var purged int64
+	err := p.transactor.WithTx(ctx, func(ctx context.Context) error {
+		locked, err := p.repo.TryLock(ctx)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			resp.Skipped = true
+			return nil
+		}
+
+		accounts, err := p.repo.PurgeBatch(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			if req.Mode == entity.PurgeModeAnonymize && req.AccountType == entity.AccountTypeUser {
+				if err := p.outbox.add(ctx, entity.EventUserAnonymized, account.Id, anonymizedEventPayload{UserOrder: account.Order}); err != nil {
+					return err
+				}
+			}
+			if account.ImageUrl == "" {
+				continue
+			}
+			if err := p.images.Remove(ctx, account.ImageUrl); err != nil {
+				return err
+			}
+		}
+		purged = int64(len(accounts))
+		return nil
+	})
+
+	return purged, err
+}
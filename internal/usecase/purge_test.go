@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testTransactor struct{}
+
+func (testTransactor) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// testPurgeRepo reports the accounts for every batch, locked stands for another replica holding the purge lock
+type testPurgeRepo struct {
+	locked   bool
+	accounts []*entity.PurgedAccount
+	batches  []*entity.PurgeBatchReq
+}
+
+func (r *testPurgeRepo) TryLock(ctx context.Context) (bool, error) {
+	return !r.locked, nil
+}
+
+func (r *testPurgeRepo) PurgeBatch(ctx context.Context, req *entity.PurgeBatchReq) ([]*entity.PurgedAccount, error) {
+	r.batches = append(r.batches, req)
+	return r.accounts, nil
+}
+
+// testOutboxRepo records the added events, other methods are not expected
+type testOutboxRepo struct {
+	repository.OutboxStorageI
+	events []*entity.Event
+}
+
+func (r *testOutboxRepo) Add(ctx context.Context, event *entity.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+// testImages records the removed images
+type testImages struct {
+	removed []string
+}
+
+func (i *testImages) Remove(ctx context.Context, name string) error {
+	i.removed = append(i.removed, name)
+	return nil
+}
+
+type PurgeUsecaseTestSuite struct {
+	suite.Suite
+}
+
+func (s *PurgeUsecaseTestSuite) TestPurge() {
+	var (
+		repo       = &testPurgeRepo{accounts: []*entity.PurgedAccount{{Id: "id", Order: 7, ImageUrl: "image.png"}}}
+		outboxRepo = &testOutboxRepo{}
+		images     = &testImages{}
+	)
+	purge := NewPurgeService(time.Second, repo, outboxRepo, images, testTransactor{}, time.Hour, 2, entity.PurgeModeDelete)
+
+	resp, err := purge.Purge(context.Background())
+	s.Suite.NoError(err)
+	s.Suite.False(resp.Skipped)
+	// a batch smaller than the batch size ends the run of an account type
+	s.Suite.Equal(entity.PurgeResp{Users: 1, Admins: 1}, *resp)
+	s.Suite.Len(repo.batches, 2)
+	s.Suite.Equal(entity.AccountTypeUser, repo.batches[0].AccountType)
+	s.Suite.Equal(entity.AccountTypeAdmin, repo.batches[1].AccountType)
+	s.Suite.Equal(uint64(2), repo.batches[0].Limit)
+	s.Suite.Equal(repo.batches[0].PurgedAt.Add(-time.Hour), repo.batches[0].DeletedBefore)
+
+	// the images of the removed accounts go with them
+	s.Suite.Equal([]string{"image.png", "image.png"}, images.removed)
+	s.Suite.Empty(outboxRepo.events)
+}
+
+func (s *PurgeUsecaseTestSuite) TestPurgeAnonymize() {
+	var (
+		repo       = &testPurgeRepo{accounts: []*entity.PurgedAccount{{Id: "id", Order: 7}}}
+		outboxRepo = &testOutboxRepo{}
+		images     = &testImages{}
+	)
+	purge := NewPurgeService(time.Second, repo, outboxRepo, images, testTransactor{}, time.Hour, 2, entity.PurgeModeAnonymize)
+
+	_, err := purge.Purge(context.Background())
+	s.Suite.NoError(err)
+	s.Suite.Empty(images.removed)
+
+	// anonymized users are announced like the ones erased through the RPC
+	s.Suite.Len(outboxRepo.events, 1)
+	s.Suite.Equal(entity.EventUserAnonymized, outboxRepo.events[0].Type)
+	s.Suite.Equal("id", outboxRepo.events[0].AggregateId)
+	s.Suite.Equal(anonymizedEventPayload{UserOrder: 7}, outboxRepo.events[0].Payload)
+}
+
+func (s *PurgeUsecaseTestSuite) TestPurgeSkippedWhileLocked() {
+	repo := &testPurgeRepo{locked: true, accounts: []*entity.PurgedAccount{{Id: "id"}}}
+	purge := NewPurgeService(time.Second, repo, &testOutboxRepo{}, &testImages{}, testTransactor{}, time.Hour, 2, entity.PurgeModeDelete)
+
+	resp, err := purge.Purge(context.Background())
+	s.Suite.NoError(err)
+	s.Suite.True(resp.Skipped)
+	s.Suite.Empty(repo.batches)
+	s.Suite.Equal(int64(0), resp.Users+resp.Admins)
+}
+
+func TestPurgeUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(PurgeUsecaseTestSuite))
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/token"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type refreshTokens struct {
+	accountType string
+	repo        repository.RefreshTokenStorageI
+	ttl         time.Duration
+}
+
+// issue stores the hash of a freshly signed in token as the start of a new family
+func (r refreshTokens) issue(ctx context.Context, accountId, refreshToken, deviceId string) error {
+	now := time.Now().UTC()
+
+	return r.repo.Create(ctx, &entity.RefreshToken{
+		Id:          uuid.NewString(),
+		AccountId:   accountId,
+		AccountType: r.accountType,
+		FamilyId:    uuid.NewString(),
+		TokenHash:   token.Hash(refreshToken),
+		DeviceId:    deviceId,
+		ExpiresAt:   now.Add(r.ttl),
+		CreatedAt:   now,
+	})
+}
+
+func (r refreshTokens) rotate(ctx context.Context, req *entity.RotateRefreshTokenReq) (*entity.RotateRefreshTokenResp, error) {
+	if req.RefreshToken == "" || req.NewRefreshToken == "" {
+		return nil, entity.NewErrNoRequiredParameter("refresh_token", "new_refresh_token")
+	}
+	now := time.Now().UTC()
+
+	return r.repo.Rotate(ctx, token.Hash(req.RefreshToken), &entity.RefreshToken{
+		Id:          uuid.NewString(),
+		AccountType: r.accountType,
+		TokenHash:   token.Hash(req.NewRefreshToken),
+		ExpiresAt:   now.Add(r.ttl),
+		CreatedAt:   now,
+	})
+}
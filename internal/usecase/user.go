@@ -5,34 +5,70 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/repository"
 	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/password"
+	"dennic_user_service/internal/pkg/translit"
+	"errors"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
 	UserServiceName = "userService"
 	UserSpanName    = "userUsecase"
+
+	searchDefaultLimit = 20
 )
 
 type UserStorageI interface {
 	Create(ctx context.Context, user *entity.User) (string, error)
+	CreateFromMessage(ctx context.Context, msg *entity.ProcessedMessage, user *entity.User) error
 	Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error)
 	List(ctx context.Context, req *entity.GetAllReq) ([]*entity.User, error)
+	Search(ctx context.Context, req *entity.SearchUsersReq) ([]*entity.User, error)
 	Update(ctx context.Context, kyc *entity.User) error
 	Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error)
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	RotateRefreshToken(ctx context.Context, req *entity.RotateRefreshTokenReq) (*entity.RotateRefreshTokenResp, error)
+	VerifyCredentials(ctx context.Context, req *entity.VerifyCredentialsReq) (*entity.VerifyCredentialsResp, error)
+	Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error)
+	Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error)
+	Export(ctx context.Context, req *entity.ExportUserDataReq) (*entity.UserDataExport, error)
+	Anonymize(ctx context.Context, req *entity.AnonymizeAccountReq) (*entity.AnonymizeAccountResp, error)
 }
 
 type userService struct {
-	repo       repository.UserStorageI
-	ctxTimeout time.Duration
+	repo          repository.UserStorageI
+	lockout       lockout
+	refreshTokens refreshTokens
+	outbox        outbox
+	messages      repository.ProcessedMessageStorageI
+	images        repository.ImageStorageI
+	transactor    repository.Transactor
+	ctxTimeout    time.Duration
 }
 
-func NewUserService(ctxTimeout time.Duration, repo repository.UserStorageI) userService {
+func NewUserService(ctxTimeout time.Duration, repo repository.UserStorageI, loginAttemptRepo repository.LoginAttemptStorageI, refreshTokenRepo repository.RefreshTokenStorageI, refreshTokenTTL time.Duration, outboxRepo repository.OutboxStorageI, processedMessageRepo repository.ProcessedMessageStorageI, images repository.ImageStorageI, transactor repository.Transactor) userService {
 	return userService{
 		ctxTimeout: ctxTimeout,
 		repo:       repo,
+		messages:   processedMessageRepo,
+		images:     images,
+		transactor: transactor,
+		outbox: outbox{
+			repo: outboxRepo,
+		},
+		lockout: lockout{
+			accountType: entity.AccountTypeUser,
+			attempts:    loginAttemptRepo,
+		},
+		refreshTokens: refreshTokens{
+			accountType: entity.AccountTypeUser,
+			repo:        refreshTokenRepo,
+			ttl:         refreshTokenTTL,
+		},
 	}
 }
 
@@ -42,8 +78,64 @@ func (u userService) Create(ctx context.Context, user *entity.User) (string, err
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Create")
 	defer span.End()
-	userId := user.Id
-	return userId, u.repo.Create(ctx, user)
+
+	hash, err := password.Hash(user.Password)
+	if err != nil {
+		return "", err
+	}
+	user.Password = hash
+
+	if err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		return u.create(ctx, user)
+	}); err != nil {
+		return "", err
+	}
+
+	return user.Id, nil
+}
+
+// CreateFromMessage creates the user carried by a consumed message once. A redelivery of the
+// message, or a user already stored with the same data, is acknowledged without an error, while
+// a message id reused with another payload is rejected with a conflict.
+func (u userService) CreateFromMessage(ctx context.Context, msg *entity.ProcessedMessage, user *entity.User) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"CreateFromMessage")
+	defer span.End()
+	span.SetAttributes(attribute.Key("user_id").String(user.Id))
+
+	plain := user.Password
+	hash, err := password.Hash(plain)
+	if err != nil {
+		return err
+	}
+	user.Password = hash
+
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		claim, err := u.messages.Claim(ctx, msg)
+		if err != nil {
+			return err
+		}
+		if !claim.Claimed {
+			if claim.PayloadHash != msg.PayloadHash {
+				return entity.ErrorMessageReused
+			}
+			return nil
+		}
+
+		// the user is created under a savepoint, so a conflict leaves the claim usable
+		createErr := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+			return u.create(ctx, user)
+		})
+		if !errors.Is(createErr, entity.ErrorConflict) {
+			return createErr
+		}
+		if same, err := u.isStored(ctx, user, plain); err != nil || same {
+			return err
+		}
+		return createErr
+	})
 }
 
 func (u userService) Get(ctx context.Context, req *entity.FieldValueReq) (*entity.User, error) {
@@ -73,7 +165,12 @@ func (u userService) Update(ctx context.Context, articleCategory *entity.User) e
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Update")
 	defer span.End()
 
-	return u.repo.Update(ctx, articleCategory)
+	return u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if err := u.repo.Update(ctx, articleCategory); err != nil {
+			return err
+		}
+		return u.addUserEvent(ctx, entity.EventUserUpdated, articleCategory.Id)
+	})
 }
 
 func (u userService) Delete(ctx context.Context, req *entity.FieldValueReq) (*entity.CheckDeleteResp, error) {
@@ -83,7 +180,29 @@ func (u userService) Delete(ctx context.Context, req *entity.FieldValueReq) (*en
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Delete")
 	defer span.End()
 
-	return u.repo.Delete(ctx, req)
+	payload := deletedEventPayload{Mode: entity.DeleteModeSoft}
+	if req.DeleteStatus {
+		payload.Mode = entity.DeleteModeHard
+	}
+
+	var resp *entity.CheckDeleteResp
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if resp, err = u.repo.Delete(ctx, req); err != nil {
+			return err
+		}
+		for _, id := range resp.Ids {
+			if err := u.outbox.add(ctx, entity.EventUserDeleted, id, payload); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (u userService) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
@@ -103,7 +222,24 @@ func (u userService) ChangePassword(ctx context.Context, req *entity.ChangeUserP
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ChangePassword")
 	defer span.End()
 
-	return u.repo.ChangePassword(ctx, req)
+	hash, err := password.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	req.Password = hash
+
+	var resp *entity.ChangePasswordResp
+	err = u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		if resp, err = u.repo.ChangePassword(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		return u.outbox.add(ctx, entity.EventUserPasswordChanged, resp.Id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (u userService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
@@ -113,5 +249,342 @@ func (u userService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateR
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"UpdateRefreshToken")
 	defer span.End()
 
-	return u.repo.UpdateRefreshToken(ctx, req)
+	if req.RefreshToken == "" {
+		return nil, entity.NewErrNoRequiredParameter("refresh_token")
+	}
+
+	_, err := u.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: req.Id,
+	})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return &entity.UpdateRefreshTokenResp{Status: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.refreshTokens.issue(ctx, req.Id, req.RefreshToken, req.DeviceId); err != nil {
+		return nil, err
+	}
+
+	return &entity.UpdateRefreshTokenResp{Status: true}, nil
+}
+
+func (u userService) RotateRefreshToken(ctx context.Context, req *entity.RotateRefreshTokenReq) (*entity.RotateRefreshTokenResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"RotateRefreshToken")
+	defer span.End()
+
+	resp, err := u.refreshTokens.rotate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ReuseDetected {
+		span.SetAttributes(attribute.Key("user_id").String(resp.AccountId))
+		span.AddEvent("refresh token reuse detected, token family revoked")
+	}
+
+	return resp, nil
+}
+
+func (u userService) VerifyCredentials(ctx context.Context, req *entity.VerifyCredentialsReq) (*entity.VerifyCredentialsResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"VerifyCredentials")
+	defer span.End()
+
+	if req.PhoneNumber == "" {
+		return nil, entity.NewErrNoRequiredParameter("phone_number")
+	}
+
+	identifier := req.PhoneNumber
+	now := time.Now().UTC()
+
+	throttled, err := u.lockout.throttled(ctx, identifier, now)
+	if err != nil {
+		return nil, err
+	}
+	if throttled {
+		return lockedResp(time.Time{}), nil
+	}
+
+	creds, err := u.repo.GetCredentials(ctx, &entity.FieldValueReq{
+		Field: "phone_number",
+		Value: req.PhoneNumber,
+	})
+	if errors.Is(err, entity.ErrorNotFound) {
+		password.CompareUnknown(req.Password)
+		if err := u.lockout.record(ctx, "", identifier, false, now); err != nil {
+			return nil, err
+		}
+		return &entity.VerifyCredentialsResp{Valid: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Key("user_id").String(creds.Id))
+
+	if creds.LockedUntil.After(now) {
+		password.CompareUnknown(req.Password)
+		if err := u.lockout.record(ctx, creds.Id, identifier, false, now); err != nil {
+			return nil, err
+		}
+		return lockedResp(creds.LockedUntil), nil
+	}
+
+	valid, err := password.Compare(creds.Password, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.lockout.record(ctx, creds.Id, identifier, valid, now); err != nil {
+		return nil, err
+	}
+	if !valid {
+		lockedUntil, err := u.lockout.registerFailure(ctx, u.repo, creds.Id, now)
+		if err != nil {
+			return nil, err
+		}
+		if !lockedUntil.IsZero() {
+			return lockedResp(lockedUntil), nil
+		}
+		return &entity.VerifyCredentialsResp{Valid: false}, nil
+	}
+
+	if creds.FailedLoginAttempts > 0 || !creds.LockedUntil.IsZero() {
+		if _, err := u.repo.Unlock(ctx, &entity.UnlockAccountReq{Id: creds.Id}); err != nil {
+			return nil, err
+		}
+	}
+
+	// legacy rows still hold plaintext passwords, replace them now that we know the secret
+	if creds.PasswordRehash || password.NeedsRehash(creds.Password) {
+		hash, err := password.Hash(req.Password)
+		if err == nil {
+			err = u.repo.RehashPassword(ctx, &entity.RehashPasswordReq{
+				Id:       creds.Id,
+				Password: hash,
+			})
+		}
+		if err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	return &entity.VerifyCredentialsResp{
+		Valid:  true,
+		Id:     creds.Id,
+		Role:   creds.Role,
+		Status: entity.AccountStatusActive,
+	}, nil
+}
+
+// Search matches the query in both Latin and Cyrillic spelling, best matches first
+func (u userService) Search(ctx context.Context, req *entity.SearchUsersReq) ([]*entity.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Search")
+	defer span.End()
+
+	req.Terms = translit.Variants(req.Query)
+	if req.Limit == 0 {
+		req.Limit = searchDefaultLimit
+	}
+
+	return u.repo.Search(ctx, req)
+}
+
+func (u userService) Unlock(ctx context.Context, req *entity.UnlockAccountReq) (*entity.UnlockAccountResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Unlock")
+	defer span.End()
+
+	var resp *entity.UnlockAccountResp
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if resp, err = u.repo.Unlock(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		creds, err := u.repo.GetCredentials(ctx, &entity.FieldValueReq{
+			Field: "id",
+			Value: req.Id,
+		})
+		if err != nil {
+			return err
+		}
+		return u.lockout.clear(ctx, creds.PhoneNumber)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (u userService) Restore(ctx context.Context, req *entity.RestoreAccountReq) (*entity.RestoreAccountResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Restore")
+	defer span.End()
+
+	var resp *entity.RestoreAccountResp
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		if resp, err = u.repo.Restore(ctx, req); err != nil || !resp.Status {
+			return err
+		}
+		return u.outbox.add(ctx, entity.EventUserRestored, req.Id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Anonymize scrubs the personal data of a user and removes its image from storage. The image is
+// removed before the transaction commits, so a failed removal leaves the user untouched and the
+// call can be repeated.
+func (u userService) Anonymize(ctx context.Context, req *entity.AnonymizeAccountReq) (*entity.AnonymizeAccountResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Anonymize")
+	defer span.End()
+	span.SetAttributes(attribute.Key("user_id").String(req.Id))
+
+	var resp *entity.AnonymizeAccountResp
+	err := u.transactor.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = u.repo.Anonymize(ctx, req)
+		if err != nil || !resp.Status {
+			return err
+		}
+		if err := u.outbox.add(ctx, entity.EventUserAnonymized, req.Id, anonymizedEventPayload{UserOrder: resp.UserOrder}); err != nil {
+			return err
+		}
+		if resp.ImageUrl == "" {
+			return nil
+		}
+		return u.images.Remove(ctx, resp.ImageUrl)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Export collects the profile of an active user together with its login history and sessions
+func (u userService) Export(ctx context.Context, req *entity.ExportUserDataReq) (*entity.UserDataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Export")
+	defer span.End()
+	span.SetAttributes(attribute.Key("user_id").String(req.Id))
+
+	user, err := u.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	account := &entity.AccountReq{
+		AccountType: entity.AccountTypeUser,
+		AccountId:   user.Id,
+	}
+	attempts, err := u.lockout.attempts.ListByAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := u.refreshTokens.repo.ListByAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.UserDataExport{
+		User:          user,
+		LoginAttempts: attempts,
+		Sessions:      sessions,
+	}, nil
+}
+
+// create stores the user with its refresh token and the created event in the transaction in ctx
+func (u userService) create(ctx context.Context, user *entity.User) error {
+	if err := u.repo.Create(ctx, user); err != nil {
+		return err
+	}
+	if user.RefreshToken != "" {
+		if err := u.refreshTokens.issue(ctx, user.Id, user.RefreshToken, ""); err != nil {
+			return err
+		}
+	}
+	return u.addUserEvent(ctx, entity.EventUserCreated, user.Id)
+}
+
+// isStored reports whether the user is already stored with the same profile and password,
+// plain is the password before hashing
+func (u userService) isStored(ctx context.Context, user *entity.User, plain string) (bool, error) {
+	stored, err := u.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: user.Id,
+	})
+	if errors.Is(err, entity.ErrorNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if stored.FirstName != user.FirstName ||
+		stored.LastName != user.LastName ||
+		!sameDate(stored.BirthDate, user.BirthDate) ||
+		stored.PhoneNumber != user.PhoneNumber ||
+		stored.Gender != user.Gender ||
+		stored.ImageUrl != user.ImageUrl {
+		return false, nil
+	}
+
+	creds, err := u.repo.GetCredentials(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: user.Id,
+	})
+	if err != nil {
+		return false, err
+	}
+	return password.Compare(creds.Password, plain)
+}
+
+// sameDate compares dates given either as a date or as a timestamp
+func sameDate(a, b string) bool {
+	const dateLen = len("2006-01-02")
+	if len(a) > dateLen {
+		a = a[:dateLen]
+	}
+	if len(b) > dateLen {
+		b = b[:dateLen]
+	}
+	return a == b
+}
+
+// addUserEvent records an event carrying the user as stored by the transaction in ctx
+func (u userService) addUserEvent(ctx context.Context, eventType, id string) error {
+	user, err := u.repo.Get(ctx, &entity.FieldValueReq{
+		Field: "id",
+		Value: id,
+	})
+	if err != nil {
+		return err
+	}
+	return u.outbox.add(ctx, eventType, id, newUserEventPayload(user))
 }
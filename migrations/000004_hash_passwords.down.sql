@@ -0,0 +1,4 @@
+ALTER TABLE admins DROP COLUMN IF EXISTS password_rehash;
+ALTER TABLE users DROP COLUMN IF EXISTS password_rehash;
+
+-- password columns keep their width, stored argon2id hashes do not fit the original VARCHAR(50).
@@ -0,0 +1,9 @@
+ALTER TABLE users ALTER COLUMN password TYPE VARCHAR(255);
+ALTER TABLE admins ALTER COLUMN password TYPE VARCHAR(255);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_rehash BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS password_rehash BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- rows written before hashing was introduced still hold plaintext passwords, they are rehashed on next successful login.
+UPDATE users SET password_rehash = TRUE WHERE password NOT LIKE '$argon2id$%';
+UPDATE admins SET password_rehash = TRUE WHERE password NOT LIKE '$argon2id$%';
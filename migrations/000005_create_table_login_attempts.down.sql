@@ -0,0 +1,11 @@
+ALTER TABLE admins DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE admins DROP COLUMN IF EXISTS failed_login_attempts;
+
+ALTER TABLE users DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE users DROP COLUMN IF EXISTS failed_login_attempts;
+
+DROP INDEX IF EXISTS login_attempts_account_id_idx;
+
+DROP INDEX IF EXISTS login_attempts_identifier_created_at_idx;
+
+DROP TABLE IF EXISTS login_attempts;
@@ -0,0 +1,18 @@
+/*login_attempts table*/
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    account_type VARCHAR(10) NOT NULL,
+    account_id UUID,
+    identifier VARCHAR(100) NOT NULL,
+    success BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX login_attempts_identifier_created_at_idx ON login_attempts(account_type, identifier, created_at); --failure counters per phone number / email inside a time window.
+CREATE INDEX login_attempts_account_id_idx ON login_attempts(account_id);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
@@ -0,0 +1,11 @@
+/*only token hashes were kept, the plaintext values cannot be restored and every account has to sign in again*/
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS refresh_token TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS refresh_token TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS refresh_tokens_account_idx;
+
+DROP INDEX IF EXISTS refresh_tokens_family_id_idx;
+
+DROP INDEX IF EXISTS refresh_tokens_token_hash_idx;
+
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,32 @@
+/*refresh_tokens table*/
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID NOT NULL PRIMARY KEY,
+    account_id UUID NOT NULL,
+    account_type VARCHAR(10) NOT NULL,
+    family_id UUID NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    device_id VARCHAR(100) NOT NULL DEFAULT '',
+    parent_id UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL,
+    rotated_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX refresh_tokens_token_hash_idx ON refresh_tokens(account_type, token_hash); --only the sha-256 of a token is stored, lookups go through the hash.
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens(family_id);
+CREATE INDEX refresh_tokens_account_idx ON refresh_tokens(account_type, account_id, device_id) WHERE rotated_at IS NULL AND revoked_at IS NULL;
+
+/*carry over the single plaintext token of every account as the start of a new family*/
+INSERT INTO refresh_tokens (id, account_id, account_type, family_id, token_hash, expires_at)
+SELECT md5(random()::text || id::text)::uuid, id, 'user', md5(random()::text || id::text)::uuid, encode(sha256(refresh_token::bytea), 'hex'), CURRENT_TIMESTAMP + INTERVAL '30 days'
+FROM users
+WHERE refresh_token <> '' AND deleted_at IS NULL;
+
+INSERT INTO refresh_tokens (id, account_id, account_type, family_id, token_hash, expires_at)
+SELECT md5(random()::text || id::text)::uuid, id, 'admin', md5(random()::text || id::text)::uuid, encode(sha256(refresh_token::bytea), 'hex'), CURRENT_TIMESTAMP + INTERVAL '30 days'
+FROM admins
+WHERE refresh_token <> '' AND deleted_at IS NULL;
+
+ALTER TABLE users DROP COLUMN IF EXISTS refresh_token;
+ALTER TABLE admins DROP COLUMN IF EXISTS refresh_token;
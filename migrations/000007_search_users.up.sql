@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+/*trigram indexes for fuzzy user search*/
+CREATE INDEX IF NOT EXISTS users_full_name_trgm_idx ON users USING GIN ((first_name || ' ' || last_name) gin_trgm_ops); --similarity over first and last name together, tolerates typos and either name alone.
+CREATE INDEX IF NOT EXISTS users_phone_number_trgm_idx ON users USING GIN (phone_number gin_trgm_ops); --substring match on phone numbers.
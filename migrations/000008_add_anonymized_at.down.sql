@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS admins_purge_idx;
+
+DROP INDEX IF EXISTS users_purge_idx;
+
+ALTER TABLE admins DROP COLUMN IF EXISTS anonymized_at;
+ALTER TABLE users DROP COLUMN IF EXISTS anonymized_at;
@@ -0,0 +1,5 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMP; --set once the purge job has replaced personal data of a soft deleted user.
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_purge_idx ON users(deleted_at) WHERE deleted_at IS NOT NULL AND anonymized_at IS NULL;
+CREATE INDEX IF NOT EXISTS admins_purge_idx ON admins(deleted_at) WHERE deleted_at IS NOT NULL AND anonymized_at IS NULL;
@@ -0,0 +1,15 @@
+/*outbox table, events are written in the transaction of the change they announce and relayed to the broker afterwards*/
+CREATE TABLE IF NOT EXISTS outbox (
+    id UUID NOT NULL PRIMARY KEY,
+    seq BIGSERIAL NOT NULL,
+    aggregate_id VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB,
+    occurred_at TIMESTAMP NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS outbox_seq_idx ON outbox(seq); --relay order, events are published in the order they were written.
+CREATE INDEX IF NOT EXISTS outbox_aggregate_seq_idx ON outbox(aggregate_id, seq); --events of one account wait for the earlier ones.
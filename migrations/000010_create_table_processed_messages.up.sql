@@ -0,0 +1,8 @@
+/*processed messages, a consumed message is recorded in the transaction of its change so a redelivery is acknowledged without applying it twice*/
+CREATE TABLE IF NOT EXISTS processed_messages (
+    consumer VARCHAR(100) NOT NULL,
+    id VARCHAR(100) NOT NULL,
+    payload_hash CHAR(64) NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (consumer, id)
+);
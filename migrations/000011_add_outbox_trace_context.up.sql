@@ -0,0 +1 @@
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS trace_context JSONB; --trace of the change an event announces, the relay publishes the event in that trace.
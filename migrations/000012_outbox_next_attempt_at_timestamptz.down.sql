@@ -0,0 +1 @@
+ALTER TABLE outbox ALTER COLUMN next_attempt_at TYPE TIMESTAMP USING next_attempt_at AT TIME ZONE 'UTC';
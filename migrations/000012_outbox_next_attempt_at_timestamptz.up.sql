@@ -0,0 +1 @@
+ALTER TABLE outbox ALTER COLUMN next_attempt_at TYPE TIMESTAMPTZ USING next_attempt_at AT TIME ZONE 'UTC'; --the default and the relay clock are compared as instants, whatever time zone the session has.